@@ -0,0 +1,453 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// buildDetailsTree extends buildSummaryTree with artifacts and change sets.
+const buildDetailsTree = buildSummaryTree + ",artifacts[fileName,relativePath]," +
+	"changeSet[items[commitId,msg,timestamp,author[fullName]]]," +
+	"changeSets[items[commitId,msg,timestamp,author[fullName]]]"
+
+// buildAliases lists the permalinks a build can be looked up by.
+var buildAliases = []string{
+	"lastBuild",
+	"lastCompletedBuild",
+	"lastSuccessfulBuild",
+	"lastStableBuild",
+	"lastUnstableBuild",
+	"lastFailedBuild",
+	"lastUnsuccessfulBuild",
+}
+
+// Ensure the implementation satisfies the expected data source interfaces.
+var _ datasource.DataSource = &jenkinsBuildDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &jenkinsBuildDataSource{}
+
+// NewJenkinsBuildDataSource is a helper function to simplify provider development.
+func NewJenkinsBuildDataSource() datasource.DataSource {
+	return &jenkinsBuildDataSource{}
+}
+
+// jenkinsBuildDataSource defines the data source implementation.
+type jenkinsBuildDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsBuildDataSourceModel describes the data source data model for a single build.
+type jenkinsBuildDataSourceModel struct {
+	ID          types.String         `tfsdk:"id"`           // Unique identifier (job full name and build number)
+	Job         types.String         `tfsdk:"job"`          // Full name of the job
+	Number      types.Int64          `tfsdk:"number"`       // Build number to look up, or the resolved one
+	Alias       types.String         `tfsdk:"alias"`        // Permalink to look up, e.g. lastSuccessfulBuild
+	URL         types.String         `tfsdk:"url"`          // Build URL (computed)
+	Result      types.String         `tfsdk:"result"`       // Build result (computed)
+	Building    types.Bool           `tfsdk:"building"`     // Whether the build is running (computed)
+	Timestamp   types.String         `tfsdk:"timestamp"`    // Start time (computed)
+	Duration    types.Int64          `tfsdk:"duration"`     // Duration in milliseconds (computed)
+	Causes      types.List           `tfsdk:"causes"`       // What triggered the build (computed)
+	Parameters  types.Map            `tfsdk:"parameters"`   // Build parameters (computed)
+	Artifacts   []buildArtifactModel `tfsdk:"artifacts"`    // Archived artifacts (computed)
+	TestResults *testResultsModel    `tfsdk:"test_results"` // JUnit test summary (computed)
+	Changes     []buildChangeModel   `tfsdk:"changes"`      // Change set entries (computed)
+}
+
+// buildArtifactModel describes an archived artifact.
+type buildArtifactModel struct {
+	FileName     types.String `tfsdk:"file_name"`
+	RelativePath types.String `tfsdk:"relative_path"`
+	URL          types.String `tfsdk:"url"`
+}
+
+// testResultsModel summarizes the test report of a build.
+type testResultsModel struct {
+	Total    types.Int64   `tfsdk:"total"`
+	Passed   types.Int64   `tfsdk:"passed"`
+	Failed   types.Int64   `tfsdk:"failed"`
+	Skipped  types.Int64   `tfsdk:"skipped"`
+	Duration types.Float64 `tfsdk:"duration"`
+}
+
+// buildChangeModel describes a change set entry.
+type buildChangeModel struct {
+	CommitID  types.String `tfsdk:"commit_id"`
+	Author    types.String `tfsdk:"author"`
+	Message   types.String `tfsdk:"message"`
+	Timestamp types.String `tfsdk:"timestamp"`
+}
+
+// changeSetResponse mirrors a change set in the build JSON API.
+type changeSetResponse struct {
+	Items []struct {
+		CommitID  string `json:"commitId"`
+		Msg       string `json:"msg"`
+		Timestamp int64  `json:"timestamp"`
+		Author    struct {
+			FullName string `json:"fullName"`
+		} `json:"author"`
+	} `json:"items"`
+}
+
+// buildDetailsResponse mirrors the build JSON API restricted by buildDetailsTree.
+type buildDetailsResponse struct {
+	buildSummaryResponse
+	Artifacts []struct {
+		FileName     string `json:"fileName"`
+		RelativePath string `json:"relativePath"`
+	} `json:"artifacts"`
+	ChangeSet  *changeSetResponse  `json:"changeSet"`  // Freestyle jobs
+	ChangeSets []changeSetResponse `json:"changeSets"` // Pipelines
+}
+
+// testReportResponse mirrors the test report JSON API.
+type testReportResponse struct {
+	PassCount int64   `json:"passCount"`
+	FailCount int64   `json:"failCount"`
+	SkipCount int64   `json:"skipCount"`
+	Duration  float64 `json:"duration"`
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsBuildDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build" // e.g., jenkins_build
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsBuildDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves a build of a Jenkins job, with its artifacts, test results and changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job and the build number, separated by `#`.",
+				Computed:            true,
+			},
+			"job": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job, including its folder (e.g., `team/release`).",
+				Required:            true,
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the build to retrieve. When `alias` is used, the number of the build it resolved to.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "The permalink of the build to retrieve: `lastBuild`, `lastCompletedBuild`, `lastSuccessfulBuild`, `lastStableBuild`, `lastUnstableBuild`, `lastFailedBuild` or `lastUnsuccessfulBuild`. Defaults to `lastBuild` when `number` is not set.",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the build.",
+				Computed:            true,
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "The result of the build, empty while it is running.",
+				Computed:            true,
+			},
+			"building": schema.BoolAttribute{
+				MarkdownDescription: "Whether the build is still running.",
+				Computed:            true,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "The time the build started, as an RFC 3339 timestamp.",
+				Computed:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The duration of the build in milliseconds, `0` while it is running.",
+				Computed:            true,
+			},
+			"causes": schema.ListAttribute{
+				MarkdownDescription: "Descriptions of what triggered the build.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The parameters of the build. Sensitive parameter values are empty.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"artifacts": schema.ListNestedAttribute{
+				MarkdownDescription: "The artifacts archived by the build.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"file_name": schema.StringAttribute{
+							MarkdownDescription: "The file name of the artifact.",
+							Computed:            true,
+						},
+						"relative_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the artifact relative to the archive root.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The download URL of the artifact.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"test_results": schema.SingleNestedAttribute{
+				MarkdownDescription: "The summary of the JUnit test report, or null when the build has none.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"total": schema.Int64Attribute{
+						MarkdownDescription: "The number of tests.",
+						Computed:            true,
+					},
+					"passed": schema.Int64Attribute{
+						MarkdownDescription: "The number of passed tests.",
+						Computed:            true,
+					},
+					"failed": schema.Int64Attribute{
+						MarkdownDescription: "The number of failed tests.",
+						Computed:            true,
+					},
+					"skipped": schema.Int64Attribute{
+						MarkdownDescription: "The number of skipped tests.",
+						Computed:            true,
+					},
+					"duration": schema.Float64Attribute{
+						MarkdownDescription: "The total duration of the tests in seconds.",
+						Computed:            true,
+					},
+				},
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "The SCM changes included in the build.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"commit_id": schema.StringAttribute{
+							MarkdownDescription: "The commit ID.",
+							Computed:            true,
+						},
+						"author": schema.StringAttribute{
+							MarkdownDescription: "The full name of the author.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The commit message.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The time of the commit, as an RFC 3339 timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the build is identified by either a number or an alias.
+func (d *jenkinsBuildDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jenkinsBuildDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateBuildSelection(config.Number, config.Alias, &resp.Diagnostics)
+}
+
+// validateBuildSelection checks the `number` and `alias` attributes selecting a build.
+func validateBuildSelection(number types.Int64, alias types.String, diags *diag.Diagnostics) {
+	if !number.IsNull() && !alias.IsNull() {
+		diags.AddAttributeError(path.Root("alias"), "Conflicting Build Selection", "Only one of 'number' and 'alias' can be set.")
+		return
+	}
+	if alias.IsNull() || alias.IsUnknown() {
+		return
+	}
+	for _, known := range buildAliases {
+		if known == alias.ValueString() {
+			return
+		}
+	}
+	diags.AddAttributeError(
+		path.Root("alias"),
+		"Invalid Build Alias",
+		fmt.Sprintf("Alias must be one of '%s', got: '%s'.", strings.Join(buildAliases, "', '"), alias.ValueString()),
+	)
+}
+
+// buildSelector returns the URL segment of the build selected by `number` or `alias`,
+// defaulting to the last build.
+func buildSelector(number types.Int64, alias types.String) string {
+	if !number.IsNull() {
+		return fmt.Sprintf("%d", number.ValueInt64())
+	}
+	if !alias.IsNull() {
+		return alias.ValueString()
+	}
+	return "lastBuild"
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsBuildDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// artifactURL returns the download URL of an artifact of a build.
+func artifactURL(buildURL, relativePath string) string {
+	segments := strings.Split(relativePath, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.TrimSuffix(buildURL, "/") + "/artifact/" + strings.Join(segments, "/")
+}
+
+// getTestReport retrieves the test report summary of a build, or nil when it has none.
+func getTestReport(ctx context.Context, client *gojenkins.Jenkins, buildPath string) (*testReportResponse, error) {
+	report := &testReportResponse{}
+	httpResp, err := client.Requester.GetJSON(ctx, buildPath+"/testReport", report, map[string]string{
+		"tree": "passCount,failCount,skipCount,duration",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return report, nil
+}
+
+// Read retrieves a build.
+func (d *jenkinsBuildDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsBuildDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := config.Job.ValueString()
+	selector := buildSelector(config.Number, config.Alias)
+	buildPath := folderURL(jobName) + "/" + selector
+
+	build := &buildDetailsResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, buildPath, build, map[string]string{
+		"tree": buildDetailsTree,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Read Error",
+			fmt.Sprintf("Failed to get build '%s' of job '%s': %s", selector, jobName, err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Not Found",
+			fmt.Sprintf("No build '%s' found for Jenkins job '%s'.", selector, jobName),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Read Error",
+			fmt.Sprintf("Failed to get build '%s' of job '%s': Jenkins responded with status %d", selector, jobName, httpResp.StatusCode),
+		)
+		return
+	}
+
+	// Test results are served by the JUnit plugin under their own endpoint
+	buildPath = fmt.Sprintf("%s/%d", folderURL(jobName), build.Number)
+	report, err := getTestReport(ctx, d.client, buildPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Test Report Read Error",
+			fmt.Sprintf("Failed to get the test report of build #%d of job '%s': %s", build.Number, jobName, err.Error()),
+		)
+		return
+	}
+
+	causes, diags := types.ListValueFrom(ctx, types.StringType, build.causes())
+	resp.Diagnostics.Append(diags...)
+	parameters, diags := types.MapValueFrom(ctx, types.StringType, build.parameters())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifacts := []buildArtifactModel{}
+	for _, artifact := range build.Artifacts {
+		artifacts = append(artifacts, buildArtifactModel{
+			FileName:     types.StringValue(artifact.FileName),
+			RelativePath: types.StringValue(artifact.RelativePath),
+			URL:          types.StringValue(artifactURL(build.URL, artifact.RelativePath)),
+		})
+	}
+
+	changeSets := build.ChangeSets
+	if build.ChangeSet != nil {
+		changeSets = append(changeSets, *build.ChangeSet)
+	}
+	changes := []buildChangeModel{}
+	for _, changeSet := range changeSets {
+		for _, item := range changeSet.Items {
+			changes = append(changes, buildChangeModel{
+				CommitID:  types.StringValue(item.CommitID),
+				Author:    types.StringValue(item.Author.FullName),
+				Message:   types.StringValue(item.Msg),
+				Timestamp: types.StringValue(time.UnixMilli(item.Timestamp).UTC().Format(time.RFC3339)),
+			})
+		}
+	}
+
+	// Update the state
+	config.ID = types.StringValue(fmt.Sprintf("%s#%d", jobName, build.Number))
+	config.Number = types.Int64Value(build.Number)
+	config.URL = types.StringValue(build.URL)
+	config.Result = types.StringValue(build.Result)
+	config.Building = types.BoolValue(build.Building)
+	config.Timestamp = types.StringValue(build.startTime().Format(time.RFC3339))
+	config.Duration = types.Int64Value(build.Duration)
+	config.Causes = causes
+	config.Parameters = parameters
+	config.Artifacts = artifacts
+	config.Changes = changes
+	config.TestResults = nil
+	if report != nil {
+		config.TestResults = &testResultsModel{
+			Total:    types.Int64Value(report.PassCount + report.FailCount + report.SkipCount),
+			Passed:   types.Int64Value(report.PassCount),
+			Failed:   types.Int64Value(report.FailCount),
+			Skipped:  types.Int64Value(report.SkipCount),
+			Duration: types.Float64Value(report.Duration),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins build data source for '%s' read successfully.", config.ID.ValueString())
+}
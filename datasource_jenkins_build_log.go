@@ -0,0 +1,255 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected data source interfaces.
+var _ datasource.DataSource = &jenkinsBuildLogDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &jenkinsBuildLogDataSource{}
+
+// NewJenkinsBuildLogDataSource is a helper function to simplify provider development.
+func NewJenkinsBuildLogDataSource() datasource.DataSource {
+	return &jenkinsBuildLogDataSource{}
+}
+
+// jenkinsBuildLogDataSource defines the data source implementation.
+type jenkinsBuildLogDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsBuildLogDataSourceModel describes the data source data model for the console log of a build.
+type jenkinsBuildLogDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`           // Unique identifier (job full name and build number)
+	Job         types.String `tfsdk:"job"`          // Full name of the job
+	Number      types.Int64  `tfsdk:"number"`       // Build number to look up, or the resolved one
+	Alias       types.String `tfsdk:"alias"`        // Permalink to look up, e.g. lastSuccessfulBuild
+	StartOffset types.Int64  `tfsdk:"start_offset"` // Byte offset to read the log from
+	Pattern     types.String `tfsdk:"pattern"`      // Regular expression lines must match
+	MaxLines    types.Int64  `tfsdk:"max_lines"`    // Maximum number of lines returned
+	Text        types.String `tfsdk:"text"`         // Filtered log text (computed)
+	Matched     types.Bool   `tfsdk:"matched"`      // Whether any line matched the pattern (computed)
+	Truncated   types.Bool   `tfsdk:"truncated"`    // Whether lines were dropped by max_lines (computed)
+	NextOffset  types.Int64  `tfsdk:"next_offset"`  // Offset to continue reading from (computed)
+	MoreData    types.Bool   `tfsdk:"more_data"`    // Whether the build is still writing to the log (computed)
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsBuildLogDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build_log" // e.g., jenkins_build_log
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsBuildLogDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the console log of a Jenkins build, optionally filtered by a regular expression.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job and the build number, separated by `#`.",
+				Computed:            true,
+			},
+			"job": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job, including its folder (e.g., `team/deploy`).",
+				Required:            true,
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the build to read. When `alias` is used, the number of the build it resolved to.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "The permalink of the build to read, e.g. `lastSuccessfulBuild`. Defaults to `lastBuild` when `number` is not set.",
+				Optional:            true,
+			},
+			"start_offset": schema.Int64Attribute{
+				MarkdownDescription: "The byte offset to start reading the log from, e.g. a previous `next_offset`. Defaults to `0`.",
+				Optional:            true,
+			},
+			"pattern": schema.StringAttribute{
+				MarkdownDescription: "A regular expression (RE2 syntax). Only the lines matching it are returned.",
+				Optional:            true,
+			},
+			"max_lines": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of lines returned, after filtering; `0` for no limit. Defaults to `1000`.",
+				Optional:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The log text, after filtering and truncation.",
+				Computed:            true,
+			},
+			"matched": schema.BoolAttribute{
+				MarkdownDescription: "Whether at least one line matched `pattern`. Always `false` without a pattern.",
+				Computed:            true,
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether lines were dropped because of `max_lines`.",
+				Computed:            true,
+			},
+			"next_offset": schema.Int64Attribute{
+				MarkdownDescription: "The byte offset the log was read up to, to continue reading from.",
+				Computed:            true,
+			},
+			"more_data": schema.BoolAttribute{
+				MarkdownDescription: "Whether the build is still running and more log output may follow.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the build selection, offset, pattern and line limit.
+func (d *jenkinsBuildLogDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jenkinsBuildLogDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateBuildSelection(config.Number, config.Alias, &resp.Diagnostics)
+
+	if !config.StartOffset.IsNull() && !config.StartOffset.IsUnknown() && config.StartOffset.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("start_offset"), "Invalid Start Offset", "'start_offset' must not be negative.")
+	}
+	if !config.MaxLines.IsNull() && !config.MaxLines.IsUnknown() && config.MaxLines.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_lines"), "Invalid Max Lines", "'max_lines' must not be negative.")
+	}
+	if !config.Pattern.IsNull() && !config.Pattern.IsUnknown() {
+		if _, err := regexp.Compile(config.Pattern.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pattern"), "Invalid Pattern", fmt.Sprintf("'pattern' is not a valid regular expression: %s", err.Error()))
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsBuildLogDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read retrieves the console log of a build.
+func (d *jenkinsBuildLogDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsBuildLogDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := config.Job.ValueString()
+	selector := buildSelector(config.Number, config.Alias)
+
+	// Resolve aliases first, so that the log and the reported number belong to the same build
+	build := &buildStatusResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, folderURL(jobName)+"/"+selector, build, map[string]string{
+		"tree": "number",
+	})
+	if err == nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Not Found",
+			fmt.Sprintf("No build '%s' found for Jenkins job '%s'.", selector, jobName),
+		)
+		return
+	}
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Read Error",
+			fmt.Sprintf("Failed to get build '%s' of job '%s': %s", selector, jobName, err.Error()),
+		)
+		return
+	}
+	id := fmt.Sprintf("%s#%d", jobName, build.Number)
+
+	var raw string
+	httpResp, err = d.client.Requester.Get(ctx, fmt.Sprintf("%s/%d/logText/progressiveText", folderURL(jobName), build.Number), &raw, map[string]string{
+		"start": strconv.FormatInt(config.StartOffset.ValueInt64(), 10),
+	})
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Log Read Error",
+			fmt.Sprintf("Failed to read the console log of build '%s': %s", id, err.Error()),
+		)
+		return
+	}
+
+	nextOffset := config.StartOffset.ValueInt64() + int64(len(raw))
+	if size, err := strconv.ParseInt(httpResp.Header.Get("X-Text-Size"), 10, 64); err == nil {
+		nextOffset = size
+	}
+
+	maxLines := 1000
+	if !config.MaxLines.IsNull() {
+		maxLines = int(config.MaxLines.ValueInt64())
+	}
+
+	var lines []string
+	if raw != "" {
+		lines = strings.Split(strings.TrimSuffix(raw, "\n"), "\n")
+	}
+	matched := false
+	if !config.Pattern.IsNull() {
+		// Checked again here, as ValidateConfig skips values that were unknown during validation
+		pattern, err := regexp.Compile(config.Pattern.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pattern"), "Invalid Pattern", fmt.Sprintf("'pattern' is not a valid regular expression: %s", err.Error()))
+			return
+		}
+		filtered := []string{}
+		for _, line := range lines {
+			if pattern.MatchString(line) {
+				filtered = append(filtered, line)
+			}
+		}
+		lines = filtered
+		matched = len(lines) > 0
+	}
+
+	truncated := false
+	if maxLines > 0 && len(lines) > maxLines {
+		lines = lines[:maxLines]
+		truncated = true
+	}
+
+	// Update the state
+	config.ID = types.StringValue(id)
+	config.Number = types.Int64Value(build.Number)
+	config.Text = types.StringValue(strings.Join(lines, "\n"))
+	config.Matched = types.BoolValue(matched)
+	config.Truncated = types.BoolValue(truncated)
+	config.NextOffset = types.Int64Value(nextOffset)
+	config.MoreData = types.BoolValue(httpResp.Header.Get("X-More-Data") == "true")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins build log data source for '%s' read successfully.", id)
+}
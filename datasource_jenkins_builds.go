@@ -0,0 +1,354 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// buildSummaryTree limits the build JSON API response to the fields of buildSummaryResponse.
+const buildSummaryTree = "number,url,result,building,timestamp,duration,actions[causes[shortDescription],parameters[name,value]]"
+
+// buildScanLimit bounds the number of recent builds searched when filters are set. The
+// `builds` property is capped at 100 by Jenkins, so builds are read from `allBuilds`.
+const buildScanLimit = 1000
+
+// Ensure the implementation satisfies the expected data source interfaces.
+var _ datasource.DataSource = &jenkinsBuildsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &jenkinsBuildsDataSource{}
+
+// NewJenkinsBuildsDataSource is a helper function to simplify provider development.
+func NewJenkinsBuildsDataSource() datasource.DataSource {
+	return &jenkinsBuildsDataSource{}
+}
+
+// jenkinsBuildsDataSource defines the data source implementation.
+type jenkinsBuildsDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsBuildsDataSourceModel describes the data source data model for the build history of a job.
+type jenkinsBuildsDataSourceModel struct {
+	ID      types.String            `tfsdk:"id"`      // Identifier of the lookup (computed)
+	Job     types.String            `tfsdk:"job"`     // Full name of the job
+	Limit   types.Int64             `tfsdk:"limit"`   // Maximum number of builds to return
+	Results types.Set               `tfsdk:"results"` // Results to filter by
+	Since   types.String            `tfsdk:"since"`   // Only builds started at or after this time
+	Until   types.String            `tfsdk:"until"`   // Only builds started before this time
+	Builds  []jenkinsBuildItemModel `tfsdk:"builds"`  // Matching builds, newest first (computed)
+}
+
+// jenkinsBuildItemModel describes a single build returned by the jenkins_builds data source.
+type jenkinsBuildItemModel struct {
+	Number     types.Int64  `tfsdk:"number"`
+	URL        types.String `tfsdk:"url"`
+	Result     types.String `tfsdk:"result"`
+	Building   types.Bool   `tfsdk:"building"`
+	Timestamp  types.String `tfsdk:"timestamp"`
+	Duration   types.Int64  `tfsdk:"duration"`
+	Causes     types.List   `tfsdk:"causes"`
+	Parameters types.Map    `tfsdk:"parameters"`
+}
+
+// buildSummaryResponse mirrors the build JSON API restricted by buildSummaryTree.
+type buildSummaryResponse struct {
+	Number    int64  `json:"number"`
+	URL       string `json:"url"`
+	Result    string `json:"result"`
+	Building  bool   `json:"building"`
+	Timestamp int64  `json:"timestamp"`
+	Duration  int64  `json:"duration"`
+	Actions   []struct {
+		Causes []struct {
+			ShortDescription string `json:"shortDescription"`
+		} `json:"causes"`
+		Parameters []struct {
+			Name  string      `json:"name"`
+			Value interface{} `json:"value"`
+		} `json:"parameters"`
+	} `json:"actions"`
+}
+
+// causes returns the descriptions of what triggered the build.
+func (b *buildSummaryResponse) causes() []string {
+	causes := []string{}
+	for _, action := range b.Actions {
+		for _, cause := range action.Causes {
+			causes = append(causes, cause.ShortDescription)
+		}
+	}
+	return causes
+}
+
+// parameters returns the build parameters, formatted as strings.
+func (b *buildSummaryResponse) parameters() map[string]string {
+	parameters := map[string]string{}
+	for _, action := range b.Actions {
+		for _, parameter := range action.Parameters {
+			if parameter.Value == nil {
+				parameters[parameter.Name] = "" // e.g. password parameters
+				continue
+			}
+			switch value := parameter.Value.(type) {
+			case float64:
+				// JSON numbers decode as float64, which fmt prints in exponent form when large
+				parameters[parameter.Name] = strconv.FormatFloat(value, 'f', -1, 64)
+			default:
+				parameters[parameter.Name] = fmt.Sprint(value)
+			}
+		}
+	}
+	return parameters
+}
+
+// startTime returns the time the build started.
+func (b *buildSummaryResponse) startTime() time.Time {
+	return time.UnixMilli(b.Timestamp).UTC()
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsBuildsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_builds" // e.g., jenkins_builds
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsBuildsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the most recent builds of a Jenkins job, newest first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job.",
+				Computed:            true,
+			},
+			"job": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job, including its folder (e.g., `team/deploy`).",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of builds to return. Defaults to `10`.",
+				Optional:            true,
+			},
+			"results": schema.SetAttribute{
+				MarkdownDescription: "Only return builds with one of these results (e.g., `SUCCESS`, `FAILURE`, `UNSTABLE`, `ABORTED`). Running builds have an empty result. Filters are applied to the 1000 most recent builds.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Only return builds started at or after this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"until": schema.StringAttribute{
+				MarkdownDescription: "Only return builds started before this RFC 3339 timestamp.",
+				Optional:            true,
+			},
+			"builds": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching builds, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"number": schema.Int64Attribute{
+							MarkdownDescription: "The build number.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the build.",
+							Computed:            true,
+						},
+						"result": schema.StringAttribute{
+							MarkdownDescription: "The result of the build, empty while it is running.",
+							Computed:            true,
+						},
+						"building": schema.BoolAttribute{
+							MarkdownDescription: "Whether the build is still running.",
+							Computed:            true,
+						},
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "The time the build started, as an RFC 3339 timestamp.",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "The duration of the build in milliseconds, `0` while it is running.",
+							Computed:            true,
+						},
+						"causes": schema.ListAttribute{
+							MarkdownDescription: "Descriptions of what triggered the build (e.g., `Started by user admin`).",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"parameters": schema.MapAttribute{
+							MarkdownDescription: "The parameters of the build. Sensitive parameter values are empty.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the limit and the time window.
+func (d *jenkinsBuildsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jenkinsBuildsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Limit.IsNull() && !config.Limit.IsUnknown() && config.Limit.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid Limit", "'limit' must be at least 1.")
+	}
+	for name, value := range map[string]types.String{"since": config.Since, "until": config.Until} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Timestamp", fmt.Sprintf("'%s' must be an RFC 3339 timestamp: %s", name, err.Error()))
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsBuildsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read lists the builds of a job.
+func (d *jenkinsBuildsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsBuildsDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := config.Job.ValueString()
+	limit := int64(10)
+	if !config.Limit.IsNull() {
+		limit = config.Limit.ValueInt64()
+	}
+
+	var results map[string]bool
+	if !config.Results.IsNull() {
+		var values []string
+		resp.Diagnostics.Append(config.Results.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		results = map[string]bool{}
+		for _, value := range values {
+			results[value] = true
+		}
+	}
+
+	var since, until time.Time
+	if !config.Since.IsNull() {
+		since, _ = time.Parse(time.RFC3339, config.Since.ValueString())
+	}
+	if !config.Until.IsNull() {
+		until, _ = time.Parse(time.RFC3339, config.Until.ValueString())
+	}
+
+	// Without filters, only the requested number of builds is fetched
+	tree := fmt.Sprintf("allBuilds[%s]{0,%d}", buildSummaryTree, limit)
+	if results != nil || !since.IsZero() || !until.IsZero() {
+		tree = fmt.Sprintf("allBuilds[%s]{0,%d}", buildSummaryTree, buildScanLimit)
+	}
+
+	var job struct {
+		Builds []buildSummaryResponse `json:"allBuilds"`
+	}
+	httpResp, err := d.client.Requester.GetJSON(ctx, folderURL(jobName), &job, map[string]string{
+		"tree": tree,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Builds Read Error",
+			fmt.Sprintf("Failed to list builds of job '%s': %s", jobName, err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Not Found",
+			fmt.Sprintf("No Jenkins job found with name: '%s'.", jobName),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Jenkins Builds Read Error",
+			fmt.Sprintf("Failed to list builds of job '%s': Jenkins responded with status %d", jobName, httpResp.StatusCode),
+		)
+		return
+	}
+
+	builds := []jenkinsBuildItemModel{}
+	for i := range job.Builds {
+		build := &job.Builds[i]
+		if int64(len(builds)) >= limit {
+			break
+		}
+
+		started := build.startTime()
+		if results != nil && !results[build.Result] {
+			continue
+		}
+		if !since.IsZero() && started.Before(since) {
+			continue
+		}
+		if !until.IsZero() && !started.Before(until) {
+			continue
+		}
+
+		causes, diags := types.ListValueFrom(ctx, types.StringType, build.causes())
+		resp.Diagnostics.Append(diags...)
+		parameters, diags := types.MapValueFrom(ctx, types.StringType, build.parameters())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		builds = append(builds, jenkinsBuildItemModel{
+			Number:     types.Int64Value(build.Number),
+			URL:        types.StringValue(build.URL),
+			Result:     types.StringValue(build.Result),
+			Building:   types.BoolValue(build.Building),
+			Timestamp:  types.StringValue(started.Format(time.RFC3339)),
+			Duration:   types.Int64Value(build.Duration),
+			Causes:     causes,
+			Parameters: parameters,
+		})
+	}
+
+	// Update the state
+	config.ID = types.StringValue(jobName)
+	config.Builds = builds
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins builds data source for '%s' read successfully, %d build(s) found.", jobName, len(builds))
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// jobItemTree lists the fields of each item requested by the jobs data source.
+const jobItemTree = "_class,name,fullName,url,color,buildable"
+
+// jobTypes maps the values of the `types` filter to item classes.
+var jobTypes = map[string]string{
+	"pipeline":            "org.jenkinsci.plugins.workflow.job.WorkflowJob",
+	"multibranch":         "org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject",
+	"organization_folder": "jenkins.branch.OrganizationFolder",
+	"folder":              "com.cloudbees.hudson.plugins.folder.Folder",
+	"freestyle":           "hudson.model.FreeStyleProject",
+}
+
+// Ensure the implementation satisfies the expected data source interfaces.
+var _ datasource.DataSource = &jenkinsJobsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &jenkinsJobsDataSource{}
+
+// NewJenkinsJobsDataSource is a helper function to simplify provider development.
+func NewJenkinsJobsDataSource() datasource.DataSource {
+	return &jenkinsJobsDataSource{}
+}
+
+// jenkinsJobsDataSource defines the data source implementation.
+type jenkinsJobsDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsJobsDataSourceModel describes the data source data model for a list of jobs.
+type jenkinsJobsDataSourceModel struct {
+	ID        types.String          `tfsdk:"id"`         // Identifier of the lookup (computed)
+	Folder    types.String          `tfsdk:"folder"`     // Full name of the folder to list
+	MaxDepth  types.Int64           `tfsdk:"max_depth"`  // Number of folder levels to descend into
+	Types     types.Set             `tfsdk:"types"`      // Job types to return
+	NameRegex types.String          `tfsdk:"name_regex"` // Regular expression names must match
+	Jobs      []jenkinsJobItemModel `tfsdk:"jobs"`       // Matching items (computed)
+}
+
+// jenkinsJobItemModel describes a single item returned by the jenkins_jobs data source.
+type jenkinsJobItemModel struct {
+	Name      types.String `tfsdk:"name"`
+	FullName  types.String `tfsdk:"full_name"`
+	URL       types.String `tfsdk:"url"`
+	Class     types.String `tfsdk:"class"`
+	Type      types.String `tfsdk:"type"`
+	Color     types.String `tfsdk:"color"`
+	Buildable types.Bool   `tfsdk:"buildable"`
+}
+
+// jobItemResponse mirrors an item of the jobs JSON API, including nested items of folders.
+type jobItemResponse struct {
+	Class     string            `json:"_class"`
+	Name      string            `json:"name"`
+	FullName  string            `json:"fullName"`
+	URL       string            `json:"url"`
+	Color     string            `json:"color"`
+	Buildable bool              `json:"buildable"`
+	Jobs      []jobItemResponse `json:"jobs"`
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsJobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jobs" // e.g., jenkins_jobs
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsJobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Jenkins jobs and folders, recursively.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the lookup, the folder or `all`.",
+				Computed:            true,
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The full name of the folder to list (e.g., `team`). Defaults to the Jenkins root.",
+				Optional:            true,
+			},
+			"max_depth": schema.Int64Attribute{
+				MarkdownDescription: "The number of levels to list; `1` only lists the items directly in `folder`. Defaults to `10`.",
+				Optional:            true,
+			},
+			"types": schema.SetAttribute{
+				MarkdownDescription: "Only return items of these types: `pipeline`, `multibranch`, `organization_folder`, `folder` or `freestyle`. Folders are descended into regardless of this filter.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression (RE2 syntax) the item name must match.",
+				Optional:            true,
+			},
+			"jobs": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching items, parents before their children.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the item.",
+							Computed:            true,
+						},
+						"full_name": schema.StringAttribute{
+							MarkdownDescription: "The full name of the item, including its folders.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the item.",
+							Computed:            true,
+						},
+						"class": schema.StringAttribute{
+							MarkdownDescription: "The Java class of the item.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the item as used by the `types` filter, or `other`.",
+							Computed:            true,
+						},
+						"color": schema.StringAttribute{
+							MarkdownDescription: "The status color of the last build (e.g., `blue`, `red_anime`), empty for folders.",
+							Computed:            true,
+						},
+						"buildable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the item can be built.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the depth, types and name pattern.
+func (d *jenkinsJobsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jenkinsJobsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MaxDepth.IsNull() && !config.MaxDepth.IsUnknown() && config.MaxDepth.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_depth"), "Invalid Max Depth", "'max_depth' must be at least 1.")
+	}
+	if !config.NameRegex.IsNull() && !config.NameRegex.IsUnknown() {
+		if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err.Error()))
+		}
+	}
+	if !config.Types.IsNull() && !config.Types.IsUnknown() {
+		var values []string
+		resp.Diagnostics.Append(config.Types.ElementsAs(ctx, &values, false)...)
+		for _, value := range values {
+			if _, ok := jobTypes[value]; !ok {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("types"),
+					"Invalid Job Type",
+					fmt.Sprintf("Type must be one of 'pipeline', 'multibranch', 'organization_folder', 'folder' or 'freestyle', got: '%s'.", value),
+				)
+			}
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsJobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// nestedJobsTree returns a tree query listing items `depth` levels deep.
+func nestedJobsTree(depth int64) string {
+	tree := "jobs[" + jobItemTree + "]"
+	for i := int64(1); i < depth; i++ {
+		tree = "jobs[" + jobItemTree + "," + tree + "]"
+	}
+	return tree
+}
+
+// jobType returns the `types` filter value of an item class.
+func jobType(class string) string {
+	for name, jobClass := range jobTypes {
+		if jobClass == class {
+			return name
+		}
+	}
+	return "other"
+}
+
+// Read lists the jobs.
+func (d *jenkinsJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsJobsDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder := strings.Trim(config.Folder.ValueString(), "/")
+	maxDepth := int64(10)
+	if !config.MaxDepth.IsNull() {
+		maxDepth = config.MaxDepth.ValueInt64()
+	}
+
+	var wanted map[string]bool
+	if !config.Types.IsNull() {
+		var values []string
+		resp.Diagnostics.Append(config.Types.ElementsAs(ctx, &values, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		wanted = map[string]bool{}
+		for _, value := range values {
+			wanted[value] = true
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if !config.NameRegex.IsNull() {
+		// Checked again here, as ValidateConfig skips values that were unknown during validation
+		var err error
+		nameRegex, err = regexp.Compile(config.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex", fmt.Sprintf("'name_regex' is not a valid regular expression: %s", err.Error()))
+			return
+		}
+	}
+
+	// The whole tree is fetched in a single request
+	root := &jobItemResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, folderURL(folder), root, map[string]string{
+		"tree": nestedJobsTree(maxDepth),
+	})
+	if err == nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Folder Not Found",
+			fmt.Sprintf("No Jenkins folder found with name: '%s'.", folder),
+		)
+		return
+	}
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Jobs Read Error",
+			fmt.Sprintf("Failed to list Jenkins jobs: %s", err.Error()),
+		)
+		return
+	}
+
+	jobs := []jenkinsJobItemModel{}
+	var walk func(items []jobItemResponse)
+	walk = func(items []jobItemResponse) {
+		for _, item := range items {
+			itemType := jobType(item.Class)
+			if (wanted == nil || wanted[itemType]) && (nameRegex == nil || nameRegex.MatchString(item.Name)) {
+				jobs = append(jobs, jenkinsJobItemModel{
+					Name:      types.StringValue(item.Name),
+					FullName:  types.StringValue(item.FullName),
+					URL:       types.StringValue(item.URL),
+					Class:     types.StringValue(item.Class),
+					Type:      types.StringValue(itemType),
+					Color:     types.StringValue(item.Color),
+					Buildable: types.BoolValue(item.Buildable),
+				})
+			}
+			walk(item.Jobs)
+		}
+	}
+	walk(root.Jobs)
+
+	// Update the state
+	config.ID = types.StringValue("all")
+	if folder != "" {
+		config.ID = types.StringValue(folder)
+	}
+	config.Jobs = jobs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins jobs data source read successfully, %d item(s) found.", len(jobs))
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// computerTree limits the computer API response to the fields used by the node data sources.
+const computerTree = "_class,displayName,description,idle,offline,temporarilyOffline,offlineCauseReason,jnlpAgent,numExecutors,assignedLabels[name],executors[idle],monitorData[*]"
+
+// Ensure the implementation satisfies the datasource.DataSource interface.
+var _ datasource.DataSource = &jenkinsNodeDataSource{}
+
+// NewJenkinsNodeDataSource is a helper function to simplify provider development.
+func NewJenkinsNodeDataSource() datasource.DataSource {
+	return &jenkinsNodeDataSource{}
+}
+
+// jenkinsNodeDataSource defines the data source implementation.
+type jenkinsNodeDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsNodeDataSourceModel describes the data source data model for a Jenkins node.
+type jenkinsNodeDataSourceModel struct {
+	ID                 types.String `tfsdk:"id"`                  // Unique identifier (node name)
+	Name               types.String `tfsdk:"name"`                // Name of the node to look up
+	Description        types.String `tfsdk:"description"`         // Description of the node (computed)
+	Labels             types.List   `tfsdk:"labels"`              // Labels assigned to the node (computed)
+	NumExecutors       types.Int64  `tfsdk:"num_executors"`       // Number of executors (computed)
+	BusyExecutors      types.Int64  `tfsdk:"busy_executors"`      // Number of executors running a build (computed)
+	Online             types.Bool   `tfsdk:"online"`              // Whether the node is connected and accepting builds (computed)
+	Idle               types.Bool   `tfsdk:"idle"`                // Whether all executors are idle (computed)
+	TemporarilyOffline types.Bool   `tfsdk:"temporarily_offline"` // Whether the node is marked temporarily offline (computed)
+	OfflineReason      types.String `tfsdk:"offline_reason"`      // Reason the node is offline (computed)
+	JNLPAgent          types.Bool   `tfsdk:"jnlp_agent"`          // Whether the node is an inbound agent (computed)
+}
+
+// computerResponse mirrors the computer JSON API restricted by computerTree.
+type computerResponse struct {
+	Class              string `json:"_class"`
+	DisplayName        string `json:"displayName"`
+	Description        string `json:"description"`
+	Idle               bool   `json:"idle"`
+	Offline            bool   `json:"offline"`
+	TemporarilyOffline bool   `json:"temporarilyOffline"`
+	OfflineCauseReason string `json:"offlineCauseReason"`
+	JNLPAgent          bool   `json:"jnlpAgent"`
+	NumExecutors       int64  `json:"numExecutors"`
+	AssignedLabels     []struct {
+		Name string `json:"name"`
+	} `json:"assignedLabels"`
+	Executors []struct {
+		Idle bool `json:"idle"`
+	} `json:"executors"`
+	MonitorData struct {
+		DiskSpace *struct {
+			Path string `json:"path"`
+			Size int64  `json:"size"`
+		} `json:"hudson.node_monitors.DiskSpaceMonitor"`
+		ResponseTime *struct {
+			Average int64 `json:"average"`
+		} `json:"hudson.node_monitors.ResponseTimeMonitor"`
+	} `json:"monitorData"`
+}
+
+// labels returns the labels assigned to the computer, without its implicit self-label.
+func (c *computerResponse) labels() []string {
+	labels := []string{}
+	for _, label := range c.AssignedLabels {
+		if label.Name != c.DisplayName {
+			labels = append(labels, label.Name)
+		}
+	}
+	return labels
+}
+
+// busyExecutors returns the number of executors currently running a build.
+func (c *computerResponse) busyExecutors() int64 {
+	var busy int64
+	for _, executor := range c.Executors {
+		if !executor.Idle {
+			busy++
+		}
+	}
+	return busy
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsNodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node" // e.g., jenkins_node
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsNodeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the current state of a Jenkins node.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier (name) of the Jenkins node.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jenkins node to retrieve, e.g. `(built-in)` for the controller.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the Jenkins node.",
+				Computed:            true,
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels assigned to the node, excluding the implicit label matching the node name.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"num_executors": schema.Int64Attribute{
+				MarkdownDescription: "The number of executors on the node.",
+				Computed:            true,
+			},
+			"busy_executors": schema.Int64Attribute{
+				MarkdownDescription: "The number of executors currently running a build.",
+				Computed:            true,
+			},
+			"online": schema.BoolAttribute{
+				MarkdownDescription: "Whether the node is connected and accepting builds.",
+				Computed:            true,
+			},
+			"idle": schema.BoolAttribute{
+				MarkdownDescription: "Whether all executors of the node are idle.",
+				Computed:            true,
+			},
+			"temporarily_offline": schema.BoolAttribute{
+				MarkdownDescription: "Whether the node has been marked temporarily offline.",
+				Computed:            true,
+			},
+			"offline_reason": schema.StringAttribute{
+				MarkdownDescription: "The reason the node is offline, if any.",
+				Computed:            true,
+			},
+			"jnlp_agent": schema.BoolAttribute{
+				MarkdownDescription: "Whether the node is an inbound (JNLP) agent.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsNodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read retrieves the state of a Jenkins node.
+func (d *jenkinsNodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsNodeDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeName := config.Name.ValueString()
+
+	computer := &computerResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, nodeURL(nodeName), computer, map[string]string{
+		"tree": computerTree,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error",
+			fmt.Sprintf("Failed to get Jenkins node details for '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Not Found",
+			fmt.Sprintf("No Jenkins node found with name: '%s'.", nodeName),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error",
+			fmt.Sprintf("Failed to get Jenkins node details for '%s': Jenkins responded with status %d", nodeName, httpResp.StatusCode),
+		)
+		return
+	}
+
+	labels, diags := types.ListValueFrom(ctx, types.StringType, computer.labels())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the state
+	config.ID = types.StringValue(computer.DisplayName)
+	config.Description = types.StringValue(computer.Description)
+	config.Labels = labels
+	config.NumExecutors = types.Int64Value(computer.NumExecutors)
+	config.BusyExecutors = types.Int64Value(computer.busyExecutors())
+	config.Online = types.BoolValue(!computer.Offline)
+	config.Idle = types.BoolValue(computer.Idle)
+	config.TemporarilyOffline = types.BoolValue(computer.TemporarilyOffline)
+	config.OfflineReason = types.StringValue(computer.OfflineCauseReason)
+	config.JNLPAgent = types.BoolValue(computer.JNLPAgent)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins node data source for '%s' read successfully.", nodeName)
+}
@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// builtInComputerClass is the class of the controller's own computer, whose node name is empty.
+const builtInComputerClass = "hudson.model.Hudson$MasterComputer"
+
+// Ensure the implementation satisfies the datasource.DataSource interface.
+var _ datasource.DataSource = &jenkinsNodesDataSource{}
+
+// NewJenkinsNodesDataSource is a helper function to simplify provider development.
+func NewJenkinsNodesDataSource() datasource.DataSource {
+	return &jenkinsNodesDataSource{}
+}
+
+// jenkinsNodesDataSource defines the data source implementation.
+type jenkinsNodesDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsNodesDataSourceModel describes the data source data model for the list of Jenkins nodes.
+type jenkinsNodesDataSourceModel struct {
+	ID              types.String           `tfsdk:"id"`               // Identifier of the lookup (computed)
+	LabelExpression types.String           `tfsdk:"label_expression"` // Optional label expression to filter by
+	TotalExecutors  types.Int64            `tfsdk:"total_executors"`  // Executors across the matching nodes (computed)
+	BusyExecutors   types.Int64            `tfsdk:"busy_executors"`   // Busy executors across the matching nodes (computed)
+	Nodes           []jenkinsNodeItemModel `tfsdk:"nodes"`            // Matching nodes (computed)
+}
+
+// jenkinsNodeItemModel describes a single node returned by the jenkins_nodes data source.
+type jenkinsNodeItemModel struct {
+	Name               types.String `tfsdk:"name"`
+	Labels             types.List   `tfsdk:"labels"`
+	NumExecutors       types.Int64  `tfsdk:"num_executors"`
+	BusyExecutors      types.Int64  `tfsdk:"busy_executors"`
+	Online             types.Bool   `tfsdk:"online"`
+	Idle               types.Bool   `tfsdk:"idle"`
+	TemporarilyOffline types.Bool   `tfsdk:"temporarily_offline"`
+	OfflineReason      types.String `tfsdk:"offline_reason"`
+	DiskSpacePath      types.String `tfsdk:"disk_space_path"`
+	DiskSpaceFree      types.Int64  `tfsdk:"disk_space_free"`
+	ResponseTime       types.Int64  `tfsdk:"response_time"`
+}
+
+// computerListResponse mirrors the /computer JSON API.
+type computerListResponse struct {
+	Computers []computerResponse `json:"computer"`
+}
+
+// labelNodesResponse mirrors the /label/<expression> JSON API restricted to node names.
+type labelNodesResponse struct {
+	Nodes []struct {
+		NodeName string `json:"nodeName"`
+	} `json:"nodes"`
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsNodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes" // e.g., jenkins_nodes
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsNodesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Jenkins nodes, optionally restricted to those matching a label expression.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the lookup, the label expression or `all`.",
+				Computed:            true,
+			},
+			"label_expression": schema.StringAttribute{
+				MarkdownDescription: "A Jenkins label expression (e.g., `linux && docker`). Only nodes matching it are returned. The expression is evaluated by Jenkins.",
+				Optional:            true,
+			},
+			"total_executors": schema.Int64Attribute{
+				MarkdownDescription: "The number of executors across the returned nodes.",
+				Computed:            true,
+			},
+			"busy_executors": schema.Int64Attribute{
+				MarkdownDescription: "The number of executors currently running a build across the returned nodes.",
+				Computed:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching nodes.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the node.",
+							Computed:            true,
+						},
+						"labels": schema.ListAttribute{
+							MarkdownDescription: "The labels assigned to the node, excluding the implicit label matching the node name.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"num_executors": schema.Int64Attribute{
+							MarkdownDescription: "The number of executors on the node.",
+							Computed:            true,
+						},
+						"busy_executors": schema.Int64Attribute{
+							MarkdownDescription: "The number of executors currently running a build.",
+							Computed:            true,
+						},
+						"online": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node is connected and accepting builds.",
+							Computed:            true,
+						},
+						"idle": schema.BoolAttribute{
+							MarkdownDescription: "Whether all executors of the node are idle.",
+							Computed:            true,
+						},
+						"temporarily_offline": schema.BoolAttribute{
+							MarkdownDescription: "Whether the node has been marked temporarily offline.",
+							Computed:            true,
+						},
+						"offline_reason": schema.StringAttribute{
+							MarkdownDescription: "The reason the node is offline, if any.",
+							Computed:            true,
+						},
+						"disk_space_path": schema.StringAttribute{
+							MarkdownDescription: "The path monitored by the disk space monitor.",
+							Computed:            true,
+						},
+						"disk_space_free": schema.Int64Attribute{
+							MarkdownDescription: "The free disk space in bytes, or `-1` if not reported.",
+							Computed:            true,
+						},
+						"response_time": schema.Int64Attribute{
+							MarkdownDescription: "The average response time of the node in milliseconds, or `-1` if not reported.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsNodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// matchLabelExpression returns the names of the nodes matching a label expression, as
+// evaluated by Jenkins. The built-in node is reported with an empty name.
+func matchLabelExpression(ctx context.Context, client *gojenkins.Jenkins, expression string) (map[string]bool, error) {
+	label := &labelNodesResponse{}
+	httpResp, err := client.Requester.GetJSON(ctx, "/label/"+url.PathEscape(expression), label, map[string]string{
+		"tree": "nodes[nodeName]",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+
+	matches := map[string]bool{}
+	for _, node := range label.Nodes {
+		matches[node.NodeName] = true
+	}
+	return matches, nil
+}
+
+// Read lists the Jenkins nodes.
+func (d *jenkinsNodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsNodesDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	computers := &computerListResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, "/computer", computers, map[string]string{
+		"tree": "computer[" + computerTree + "]",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Nodes Read Error",
+			fmt.Sprintf("Failed to list Jenkins nodes: %s", err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Jenkins Nodes Read Error",
+			fmt.Sprintf("Failed to list Jenkins nodes: Jenkins responded with status %d", httpResp.StatusCode),
+		)
+		return
+	}
+
+	id := "all"
+	var matches map[string]bool
+	if !config.LabelExpression.IsNull() && config.LabelExpression.ValueString() != "" {
+		id = config.LabelExpression.ValueString()
+		matches, err = matchLabelExpression(ctx, d.client, id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Jenkins Label Expression Error",
+				fmt.Sprintf("Failed to evaluate label expression '%s': %s", id, err.Error()),
+			)
+			return
+		}
+	}
+
+	nodes := []jenkinsNodeItemModel{}
+	var totalExecutors, busyExecutors int64
+	for i := range computers.Computers {
+		computer := &computers.Computers[i]
+
+		if matches != nil {
+			nodeName := computer.DisplayName
+			if computer.Class == builtInComputerClass {
+				nodeName = ""
+			}
+			if !matches[nodeName] {
+				continue
+			}
+		}
+
+		labels, diags := types.ListValueFrom(ctx, types.StringType, computer.labels())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		diskSpacePath, diskSpaceFree := "", int64(-1)
+		if computer.MonitorData.DiskSpace != nil {
+			diskSpacePath = computer.MonitorData.DiskSpace.Path
+			diskSpaceFree = computer.MonitorData.DiskSpace.Size
+		}
+		responseTime := int64(-1)
+		if computer.MonitorData.ResponseTime != nil {
+			responseTime = computer.MonitorData.ResponseTime.Average
+		}
+
+		busy := computer.busyExecutors()
+		totalExecutors += computer.NumExecutors
+		busyExecutors += busy
+
+		nodes = append(nodes, jenkinsNodeItemModel{
+			Name:               types.StringValue(computer.DisplayName),
+			Labels:             labels,
+			NumExecutors:       types.Int64Value(computer.NumExecutors),
+			BusyExecutors:      types.Int64Value(busy),
+			Online:             types.BoolValue(!computer.Offline),
+			Idle:               types.BoolValue(computer.Idle),
+			TemporarilyOffline: types.BoolValue(computer.TemporarilyOffline),
+			OfflineReason:      types.StringValue(computer.OfflineCauseReason),
+			DiskSpacePath:      types.StringValue(diskSpacePath),
+			DiskSpaceFree:      types.Int64Value(diskSpaceFree),
+			ResponseTime:       types.Int64Value(responseTime),
+		})
+	}
+
+	// Update the state
+	config.ID = types.StringValue(id)
+	config.TotalExecutors = types.Int64Value(totalExecutors)
+	config.BusyExecutors = types.Int64Value(busyExecutors)
+	config.Nodes = nodes
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins nodes data source read successfully, %d node(s) found.", len(nodes))
+}
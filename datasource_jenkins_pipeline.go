@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log" // Added for logging the Job Not Found case
+	"net/http"
+	"net/url"
 	"strings"
 
 	// "net/http" // Potentially needed for gojenkins client, ensuring it's available for client instantiation if not already there.
@@ -15,6 +17,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// jobDetailsTree lists the job fields read by the pipeline data source in a single request.
+const jobDetailsTree = "_class,name,fullName,url,buildable,disabled,inQueue,nextBuildNumber," +
+	"healthReport[score,description]," +
+	"lastCompletedBuild[number,url,result,duration]," +
+	"lastSuccessfulBuild[number,url],lastFailedBuild[number,url]," +
+	"lastStableBuild[number,url],lastUnsuccessfulBuild[number,url]"
+
 // Ensure the implementation satisfies the datasource.DataSource interface.
 var _ datasource.DataSource = &jenkinsPipelineDataSource{}
 
@@ -36,6 +45,113 @@ type jenkinsPipelineDataSourceModel struct {
 	GroovyScript      types.String `tfsdk:"groovy_script"`       // The Jenkinsfile/Groovy script content (computed)
 	LastBuildStatus   types.String `tfsdk:"last_build_status"`   // Status of the last build (computed)
 	LastBuildDuration types.Int64  `tfsdk:"last_build_duration"` // Duration of the last build in milliseconds (computed)
+
+	FullName       types.String `tfsdk:"full_name"`       // Full name of the job, including its folders (computed)
+	URL            types.String `tfsdk:"url"`             // URL of the job (computed)
+	JobClass       types.String `tfsdk:"job_class"`       // Java class of the job (computed)
+	DefinitionType types.String `tfsdk:"definition_type"` // How the pipeline script is defined: inline or scm (computed)
+	ScriptPath     types.String `tfsdk:"script_path"`     // Path of the Jenkinsfile in SCM (computed)
+
+	Buildable         types.Bool   `tfsdk:"buildable"`          // Whether the job can be built (computed)
+	Disabled          types.Bool   `tfsdk:"disabled"`           // Whether the job is disabled (computed)
+	InQueue           types.Bool   `tfsdk:"in_queue"`           // Whether a build of the job is queued (computed)
+	HealthScore       types.Int64  `tfsdk:"health_score"`       // Health score of the job, 0 to 100 (computed)
+	HealthDescription types.String `tfsdk:"health_description"` // Description of the health report (computed)
+	NextBuildNumber   types.Int64  `tfsdk:"next_build_number"`  // Number of the next build (computed)
+
+	LastSuccessfulBuildNumber   types.Int64  `tfsdk:"last_successful_build_number"`   // Number of the last successful build (computed)
+	LastSuccessfulBuildURL      types.String `tfsdk:"last_successful_build_url"`      // URL of the last successful build (computed)
+	LastFailedBuildNumber       types.Int64  `tfsdk:"last_failed_build_number"`       // Number of the last failed build (computed)
+	LastFailedBuildURL          types.String `tfsdk:"last_failed_build_url"`          // URL of the last failed build (computed)
+	LastStableBuildNumber       types.Int64  `tfsdk:"last_stable_build_number"`       // Number of the last stable build (computed)
+	LastStableBuildURL          types.String `tfsdk:"last_stable_build_url"`          // URL of the last stable build (computed)
+	LastUnsuccessfulBuildNumber types.Int64  `tfsdk:"last_unsuccessful_build_number"` // Number of the last unsuccessful build (computed)
+	LastUnsuccessfulBuildURL    types.String `tfsdk:"last_unsuccessful_build_url"`    // URL of the last unsuccessful build (computed)
+}
+
+// buildPointer mirrors a build reference, such as lastSuccessfulBuild, in the job JSON API.
+type buildPointer struct {
+	Number   int64  `json:"number"`
+	URL      string `json:"url"`
+	Result   string `json:"result"`
+	Duration int64  `json:"duration"`
+}
+
+// jobDetailsResponse mirrors the job JSON API for the fields in jobDetailsTree.
+type jobDetailsResponse struct {
+	Class           string `json:"_class"`
+	URL             string `json:"url"`
+	Name            string `json:"name"`
+	FullName        string `json:"fullName"`
+	Buildable       bool   `json:"buildable"`
+	Disabled        bool   `json:"disabled"`
+	InQueue         bool   `json:"inQueue"`
+	NextBuildNumber int64  `json:"nextBuildNumber"`
+	HealthReport    []struct {
+		Score       int64  `json:"score"`
+		Description string `json:"description"`
+	} `json:"healthReport"`
+	LastCompletedBuild    *buildPointer `json:"lastCompletedBuild"`
+	LastSuccessfulBuild   *buildPointer `json:"lastSuccessfulBuild"`
+	LastFailedBuild       *buildPointer `json:"lastFailedBuild"`
+	LastStableBuild       *buildPointer `json:"lastStableBuild"`
+	LastUnsuccessfulBuild *buildPointer `json:"lastUnsuccessfulBuild"`
+}
+
+// jobDefinitionConfig holds the parts of a job's config.xml read by the pipeline data source.
+// The root element differs per job class, so it is not checked.
+type jobDefinitionConfig struct {
+	Description string `xml:"description"`
+	Definition  *struct {
+		Class      string `xml:"class,attr"`
+		Script     string `xml:"script"`
+		ScriptPath string `xml:"scriptPath"`
+	} `xml:"definition"` // Pipeline jobs
+	Factory *struct {
+		ScriptPath string `xml:"scriptPath"`
+	} `xml:"factory"` // Multibranch pipelines
+}
+
+// pipelineDefinitionTypes maps pipeline definition classes to the values of `definition_type`.
+var pipelineDefinitionTypes = map[string]string{
+	"org.jenkinsci.plugins.workflow.cps.CpsFlowDefinition":    "inline",
+	"org.jenkinsci.plugins.workflow.cps.CpsScmFlowDefinition": "scm",
+}
+
+// jobFullNameFromKey returns the full name of a job given either its full name
+// (e.g., `team/deploy`) or its URL (e.g., `https://jenkins.example.com/job/team/job/deploy/`).
+func jobFullNameFromKey(key string) string {
+	if !strings.Contains(key, "://") && !strings.HasPrefix(key, "/job/") {
+		return strings.Trim(key, "/")
+	}
+	if parsed, err := url.Parse(key); err == nil {
+		key = parsed.EscapedPath()
+	}
+	// Anything before the first /job/ is the Jenkins context path
+	if index := strings.Index(key, "/job/"); index != -1 {
+		key = key[index:]
+	}
+	var names []string
+	segments := strings.Split(strings.Trim(key, "/"), "/")
+	for i := 0; i+1 < len(segments); i += 2 {
+		if segments[i] != "job" {
+			break // e.g., a trailing /lastBuild or /configure
+		}
+		name, err := url.PathUnescape(segments[i+1])
+		if err != nil {
+			name = segments[i+1]
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, "/")
+}
+
+// buildPointerValues returns the number and URL of a build reference, null when there is no such build.
+func buildPointerValues(build *buildPointer) (types.Int64, types.String) {
+	if build == nil {
+		return types.Int64Null(), types.StringNull()
+	}
+	return types.Int64Value(build.Number), types.StringValue(build.URL)
 }
 
 // Metadata returns the data source's metadata.
@@ -46,15 +162,15 @@ func (d *jenkinsPipelineDataSource) Metadata(ctx context.Context, req datasource
 // Schema defines the data source's schema.
 func (d *jenkinsPipelineDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Retrieves information about an existing Jenkins Pipeline job.",
+		Description: "Retrieves information about an existing Jenkins job. Pipeline jobs expose their script definition; other job classes, such as freestyle jobs or folders, are supported too.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The unique identifier (name) of the Jenkins Pipeline job. Can be used instead of `name`.",
+				MarkdownDescription: "The unique identifier of the Jenkins job: its full name or URL. Can be used instead of `name`.",
 				Optional:            true,
 				Computed:            true, // If name is provided, ID is computed
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Jenkins Pipeline job to retrieve.",
+				MarkdownDescription: "The full name of the Jenkins job to retrieve, including its folders (e.g., `team/deploy`), or its URL.",
 				Optional:            true,
 				Computed:            true, // If ID is provided, name is computed
 			},
@@ -63,7 +179,7 @@ func (d *jenkinsPipelineDataSource) Schema(ctx context.Context, req datasource.S
 				Computed:            true,
 			},
 			"groovy_script": schema.StringAttribute{
-				MarkdownDescription: "The Groovy script content for the pipeline (Jenkinsfile content).",
+				MarkdownDescription: "The Groovy script content for the pipeline (Jenkinsfile content). Null unless `definition_type` is `inline`.",
 				Computed:            true,
 			},
 			"last_build_status": schema.StringAttribute{
@@ -74,6 +190,82 @@ func (d *jenkinsPipelineDataSource) Schema(ctx context.Context, req datasource.S
 				MarkdownDescription: "The duration of the last completed build in milliseconds.",
 				Computed:            true,
 			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job, including its folders.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the job.",
+				Computed:            true,
+			},
+			"job_class": schema.StringAttribute{
+				MarkdownDescription: "The Java class of the job (e.g., `org.jenkinsci.plugins.workflow.job.WorkflowJob`, `hudson.model.FreeStyleProject`).",
+				Computed:            true,
+			},
+			"definition_type": schema.StringAttribute{
+				MarkdownDescription: "How the pipeline script is defined: `inline` or `scm`. Null for jobs without a pipeline definition.",
+				Computed:            true,
+			},
+			"script_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the Jenkinsfile in SCM, for pipelines defined from SCM and multibranch pipelines. Null otherwise.",
+				Computed:            true,
+			},
+			"buildable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the job can be built.",
+				Computed:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the job is disabled.",
+				Computed:            true,
+			},
+			"in_queue": schema.BoolAttribute{
+				MarkdownDescription: "Whether a build of the job is waiting in the queue.",
+				Computed:            true,
+			},
+			"health_score": schema.Int64Attribute{
+				MarkdownDescription: "The health score of the job, from 0 to 100. The lowest score is used when several reports are available; null without reports.",
+				Computed:            true,
+			},
+			"health_description": schema.StringAttribute{
+				MarkdownDescription: "The description of the health report used for `health_score`.",
+				Computed:            true,
+			},
+			"next_build_number": schema.Int64Attribute{
+				MarkdownDescription: "The number the next build of the job will get.",
+				Computed:            true,
+			},
+			"last_successful_build_number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the last successful build, null if there is none.",
+				Computed:            true,
+			},
+			"last_successful_build_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the last successful build, null if there is none.",
+				Computed:            true,
+			},
+			"last_failed_build_number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the last failed build, null if there is none.",
+				Computed:            true,
+			},
+			"last_failed_build_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the last failed build, null if there is none.",
+				Computed:            true,
+			},
+			"last_stable_build_number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the last stable build, null if there is none.",
+				Computed:            true,
+			},
+			"last_stable_build_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the last stable build, null if there is none.",
+				Computed:            true,
+			},
+			"last_unsuccessful_build_number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the last unsuccessful (not successful) build, null if there is none.",
+				Computed:            true,
+			},
+			"last_unsuccessful_build_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the last unsuccessful build, null if there is none.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -107,9 +299,9 @@ func (d *jenkinsPipelineDataSource) Read(ctx context.Context, req datasource.Rea
 
 	var jobName string
 	if !config.ID.IsNull() && !config.ID.IsUnknown() {
-		jobName = config.ID.ValueString()
+		jobName = jobFullNameFromKey(config.ID.ValueString())
 	} else if !config.Name.IsNull() && !config.Name.IsUnknown() {
-		jobName = config.Name.ValueString()
+		jobName = jobFullNameFromKey(config.Name.ValueString())
 	} else {
 		resp.Diagnostics.AddError(
 			"Missing Identifier",
@@ -118,17 +310,23 @@ func (d *jenkinsPipelineDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	// Get the job details directly. gojenkins.GetJob handles checking existence.
-	job, err := d.client.GetJob(ctx, jobName)
+	// Get the job details, health and build pointers in a single request
+	job := &jobDetailsResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, folderURL(jobName), job, map[string]string{
+		"tree": jobDetailsTree,
+	})
+	if err == nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Not Found",
+			fmt.Sprintf("No Jenkins job found with name/ID: '%s'.", jobName),
+		)
+		// For a data source, if not found, it's an error. For a resource, it would remove from state.
+		return
+	}
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
 	if err != nil {
-		if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "no such job") { // gojenkins might return generic errors for 404
-			resp.Diagnostics.AddError(
-				"Jenkins Job Not Found",
-				fmt.Sprintf("No Jenkins Pipeline job found with name/ID: '%s'. Error: %s", jobName, err.Error()),
-			)
-			// For a data source, if not found, it's an error. For a resource, it would remove from state.
-			return
-		}
 		resp.Diagnostics.AddError(
 			"Jenkins Job Read Error",
 			fmt.Sprintf("Failed to get Jenkins job details for '%s': %s", jobName, err.Error()),
@@ -136,60 +334,90 @@ func (d *jenkinsPipelineDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	// Get the job configuration XML from Jenkins using the job object
-	configXML, err := job.GetConfig(ctx) // FIX: Call GetConfig on the job object, not the client
+	// Get the job configuration XML from Jenkins
+	var configXML string
+	httpResp, err = d.client.Requester.GetXML(ctx, folderURL(jobName)+"/config.xml", &configXML, nil)
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Jenkins Job Config Read Error",
-			fmt.Sprintf("Failed to read Jenkins Pipeline job config for '%s': %s", jobName, err.Error()),
+			fmt.Sprintf("Failed to read Jenkins job config for '%s': %s", jobName, err.Error()),
 		)
 		return
 	}
 
-	groovyScript, err := extractGroovyScriptFromXML(configXML)
-	if err != nil {
+	definition := &jobDefinitionConfig{}
+	if err := unmarshalJenkinsXML(configXML, definition); err != nil {
 		resp.Diagnostics.AddError(
-			"Groovy Script Extraction Error",
-			fmt.Sprintf("Failed to extract Groovy script from job '%s' config: %s", jobName, err.Error()),
+			"Jenkins Job Config Parse Error",
+			fmt.Sprintf("Failed to parse Jenkins job config for '%s': %s", jobName, err.Error()),
 		)
-		groovyScript = "" // Ensure it's not nil, even if error
+		return
 	}
 
-	description, err := extractDescriptionFromXML(configXML)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Description Extraction Error",
-			fmt.Sprintf("Failed to extract description from job '%s' config: %s", jobName, err.Error()),
-		)
-		description = "" // Ensure it's not nil, even if error
+	// Only pipelines have a script definition; other job classes leave these fields null
+	config.DefinitionType = types.StringNull()
+	config.GroovyScript = types.StringNull()
+	config.ScriptPath = types.StringNull()
+	if definition.Definition != nil {
+		definitionType, ok := pipelineDefinitionTypes[definition.Definition.Class]
+		if !ok {
+			definitionType = definition.Definition.Class
+		}
+		config.DefinitionType = types.StringValue(definitionType)
+		switch definitionType {
+		case "inline":
+			config.GroovyScript = types.StringValue(definition.Definition.Script)
+		case "scm":
+			config.ScriptPath = types.StringValue(definition.Definition.ScriptPath)
+		}
+	} else if definition.Factory != nil && definition.Factory.ScriptPath != "" {
+		config.ScriptPath = types.StringValue(definition.Factory.ScriptPath)
 	}
 
 	// Get last build information
 	var lastBuildStatus string
 	var lastBuildDuration int64
+	if job.LastCompletedBuild != nil {
+		lastBuildStatus = job.LastCompletedBuild.Result
+		lastBuildDuration = job.LastCompletedBuild.Duration
+	}
 
-	// FIX: Removed `job.Raw.LastCompletedBuild != nil` check.
-	// `job.Raw.LastCompletedBuild` is a struct, not a pointer, so it's never nil.
-	// The check `Number > 0` is sufficient to determine if a meaningful build exists.
-	if job.Raw.LastCompletedBuild.Number > 0 {
-		lastBuild, err := job.GetLastCompletedBuild(ctx)
-		if err == nil {
-			lastBuildStatus = lastBuild.Raw.Result
-			lastBuildDuration = int64(lastBuild.Raw.Duration) // FIX: Cast float64 to int64
-		} else {
-			log.Printf("[WARN] Could not get last completed build details for '%s': %s", jobName, err.Error())
+	// Jenkins reports one entry per health metric; the job's health is the worst of them
+	config.HealthScore = types.Int64Null()
+	config.HealthDescription = types.StringNull()
+	for _, report := range job.HealthReport {
+		if config.HealthScore.IsNull() || report.Score < config.HealthScore.ValueInt64() {
+			config.HealthScore = types.Int64Value(report.Score)
+			config.HealthDescription = types.StringValue(report.Description)
 		}
 	}
 
 	// Update the state
-	config.ID = types.StringValue(job.Raw.Name)
-	config.Name = types.StringValue(job.Raw.Name)
-	config.Description = types.StringValue(description)
-	config.GroovyScript = types.StringValue(groovyScript)
+	if config.ID.IsNull() || config.ID.IsUnknown() {
+		config.ID = types.StringValue(job.FullName)
+	}
+	if config.Name.IsNull() || config.Name.IsUnknown() {
+		config.Name = types.StringValue(job.FullName)
+	}
+	config.FullName = types.StringValue(job.FullName)
+	config.URL = types.StringValue(job.URL)
+	config.JobClass = types.StringValue(job.Class)
+	config.Description = types.StringValue(definition.Description)
 	config.LastBuildStatus = types.StringValue(lastBuildStatus)
 	config.LastBuildDuration = types.Int64Value(lastBuildDuration)
+	config.Buildable = types.BoolValue(job.Buildable)
+	config.Disabled = types.BoolValue(job.Disabled)
+	config.InQueue = types.BoolValue(job.InQueue)
+	config.NextBuildNumber = types.Int64Value(job.NextBuildNumber)
+	config.LastSuccessfulBuildNumber, config.LastSuccessfulBuildURL = buildPointerValues(job.LastSuccessfulBuild)
+	config.LastFailedBuildNumber, config.LastFailedBuildURL = buildPointerValues(job.LastFailedBuild)
+	config.LastStableBuildNumber, config.LastStableBuildURL = buildPointerValues(job.LastStableBuild)
+	config.LastUnsuccessfulBuildNumber, config.LastUnsuccessfulBuildURL = buildPointerValues(job.LastUnsuccessfulBuild)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 
-	log.Printf("[INFO] Jenkins Pipeline data source for '%s' read successfully.", jobName)
+	log.Printf("[INFO] Jenkins job data source for '%s' read successfully.", jobName)
 }
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected data source interfaces.
+var _ datasource.DataSource = &jenkinsPipelineStagesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &jenkinsPipelineStagesDataSource{}
+
+// NewJenkinsPipelineStagesDataSource is a helper function to simplify provider development.
+func NewJenkinsPipelineStagesDataSource() datasource.DataSource {
+	return &jenkinsPipelineStagesDataSource{}
+}
+
+// jenkinsPipelineStagesDataSource defines the data source implementation.
+type jenkinsPipelineStagesDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsPipelineStagesDataSourceModel describes the data source data model for the stages of a pipeline run.
+type jenkinsPipelineStagesDataSourceModel struct {
+	ID            types.String         `tfsdk:"id"`             // Unique identifier (job full name and build number)
+	Job           types.String         `tfsdk:"job"`            // Full name of the pipeline job
+	Number        types.Int64          `tfsdk:"number"`         // Build number to look up, or the resolved one
+	Alias         types.String         `tfsdk:"alias"`          // Permalink to look up, e.g. lastSuccessfulBuild
+	Status        types.String         `tfsdk:"status"`         // Status of the run (computed)
+	StartTime     types.String         `tfsdk:"start_time"`     // Start time of the run (computed)
+	Duration      types.Int64          `tfsdk:"duration"`       // Duration of the run in milliseconds (computed)
+	QueueDuration types.Int64          `tfsdk:"queue_duration"` // Time spent in the queue in milliseconds (computed)
+	PauseDuration types.Int64          `tfsdk:"pause_duration"` // Time spent paused in milliseconds (computed)
+	Stages        []pipelineStageModel `tfsdk:"stages"`         // Stages of the run (computed)
+}
+
+// pipelineStageModel describes a stage of a pipeline run.
+type pipelineStageModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Status        types.String `tfsdk:"status"`
+	StartTime     types.String `tfsdk:"start_time"`
+	Duration      types.Int64  `tfsdk:"duration"`
+	PauseDuration types.Int64  `tfsdk:"pause_duration"`
+}
+
+// workflowRunResponse mirrors the wfapi/describe JSON API of the Pipeline Stage View plugin.
+type workflowRunResponse struct {
+	ID                  string `json:"id"`
+	Status              string `json:"status"`
+	StartTimeMillis     int64  `json:"startTimeMillis"`
+	DurationMillis      int64  `json:"durationMillis"`
+	QueueDurationMillis int64  `json:"queueDurationMillis"`
+	PauseDurationMillis int64  `json:"pauseDurationMillis"`
+	Stages              []struct {
+		ID                  string `json:"id"`
+		Name                string `json:"name"`
+		Status              string `json:"status"`
+		StartTimeMillis     int64  `json:"startTimeMillis"`
+		DurationMillis      int64  `json:"durationMillis"`
+		PauseDurationMillis int64  `json:"pauseDurationMillis"`
+	} `json:"stages"`
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsPipelineStagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pipeline_stages" // e.g., jenkins_pipeline_stages
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsPipelineStagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the stages of a pipeline run and their timings. Requires the Pipeline Stage View plugin.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job and the build number, separated by `#`.",
+				Computed:            true,
+			},
+			"job": schema.StringAttribute{
+				MarkdownDescription: "The full name of the pipeline job, including its folder (e.g., `team/deploy`).",
+				Required:            true,
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the build to retrieve. When `alias` is used, the number of the build it resolved to.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "The permalink of the build to retrieve, e.g. `lastSuccessfulBuild`. Defaults to `lastBuild` when `number` is not set.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the run (e.g., `SUCCESS`, `FAILED`, `IN_PROGRESS`, `PAUSED_PENDING_INPUT`).",
+				Computed:            true,
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "The time the run started, as an RFC 3339 timestamp.",
+				Computed:            true,
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "The duration of the run in milliseconds.",
+				Computed:            true,
+			},
+			"queue_duration": schema.Int64Attribute{
+				MarkdownDescription: "The time the run waited in the queue, in milliseconds.",
+				Computed:            true,
+			},
+			"pause_duration": schema.Int64Attribute{
+				MarkdownDescription: "The time the run was paused, e.g. waiting for input, in milliseconds.",
+				Computed:            true,
+			},
+			"stages": schema.ListNestedAttribute{
+				MarkdownDescription: "The stages of the run, in execution order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the flow node starting the stage.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the stage.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the stage.",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "The time the stage started, as an RFC 3339 timestamp.",
+							Computed:            true,
+						},
+						"duration": schema.Int64Attribute{
+							MarkdownDescription: "The duration of the stage in milliseconds.",
+							Computed:            true,
+						},
+						"pause_duration": schema.Int64Attribute{
+							MarkdownDescription: "The time the stage was paused, e.g. waiting for an `input` step, in milliseconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the build is identified by either a number or an alias.
+func (d *jenkinsPipelineStagesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jenkinsPipelineStagesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateBuildSelection(config.Number, config.Alias, &resp.Diagnostics)
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsPipelineStagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read retrieves the stages of a pipeline run.
+func (d *jenkinsPipelineStagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsPipelineStagesDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := config.Job.ValueString()
+	selector := buildSelector(config.Number, config.Alias)
+
+	// wfapi is not part of the Jenkins JSON API, so GetJSON would append /api/json to its URL
+	var raw string
+	run := &workflowRunResponse{}
+	httpResp, err := d.client.Requester.Get(ctx, folderURL(jobName)+"/"+selector+"/wfapi/describe", &raw, nil)
+	if err == nil && httpResp.StatusCode == http.StatusOK {
+		err = json.Unmarshal([]byte(raw), run)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Pipeline Stages Read Error",
+			fmt.Sprintf("Failed to get the stages of build '%s' of job '%s': %s", selector, jobName, err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins Pipeline Run Not Found",
+			fmt.Sprintf("No pipeline run '%s' found for Jenkins job '%s'. The job must be a pipeline and the Pipeline Stage View plugin must be installed.", selector, jobName),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError(
+			"Jenkins Pipeline Stages Read Error",
+			fmt.Sprintf("Failed to get the stages of build '%s' of job '%s': Jenkins responded with status %d", selector, jobName, httpResp.StatusCode),
+		)
+		return
+	}
+
+	// The run ID is the build number
+	var number int64
+	if _, err := fmt.Sscan(run.ID, &number); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Pipeline Stages Read Error",
+			fmt.Sprintf("Unexpected run ID '%s' for build '%s' of job '%s'.", run.ID, selector, jobName),
+		)
+		return
+	}
+
+	stages := []pipelineStageModel{}
+	for _, stage := range run.Stages {
+		stages = append(stages, pipelineStageModel{
+			ID:            types.StringValue(stage.ID),
+			Name:          types.StringValue(stage.Name),
+			Status:        types.StringValue(stage.Status),
+			StartTime:     types.StringValue(time.UnixMilli(stage.StartTimeMillis).UTC().Format(time.RFC3339)),
+			Duration:      types.Int64Value(stage.DurationMillis),
+			PauseDuration: types.Int64Value(stage.PauseDurationMillis),
+		})
+	}
+
+	// Update the state
+	config.ID = types.StringValue(fmt.Sprintf("%s#%d", jobName, number))
+	config.Number = types.Int64Value(number)
+	config.Status = types.StringValue(run.Status)
+	config.StartTime = types.StringValue(time.UnixMilli(run.StartTimeMillis).UTC().Format(time.RFC3339))
+	config.Duration = types.Int64Value(run.DurationMillis)
+	config.QueueDuration = types.Int64Value(run.QueueDurationMillis)
+	config.PauseDuration = types.Int64Value(run.PauseDurationMillis)
+	config.Stages = stages
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins pipeline stages data source for '%s' read successfully, %d stage(s) found.", config.ID.ValueString(), len(stages))
+}
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pluginInventoryTree lists the plugin fields read by the plugins data source.
+const pluginInventoryTree = "plugins[shortName,longName,version,url,enabled,active,hasUpdate,pinned,bundled,deleted," +
+	"dependencies[shortName,version,optional]]"
+
+// Ensure the implementation satisfies the datasource.DataSource interface.
+var _ datasource.DataSource = &jenkinsPluginsDataSource{}
+
+// NewJenkinsPluginsDataSource is a helper function to simplify provider development.
+func NewJenkinsPluginsDataSource() datasource.DataSource {
+	return &jenkinsPluginsDataSource{}
+}
+
+// jenkinsPluginsDataSource defines the data source implementation.
+type jenkinsPluginsDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsPluginsDataSourceModel describes the data source data model for the installed plugins.
+type jenkinsPluginsDataSourceModel struct {
+	ID      types.String             `tfsdk:"id"`      // Identifier of the lookup (computed)
+	Plugins []jenkinsPluginItemModel `tfsdk:"plugins"` // Installed plugins (computed)
+}
+
+// jenkinsPluginItemModel describes a single installed plugin.
+type jenkinsPluginItemModel struct {
+	ShortName    types.String                   `tfsdk:"short_name"`
+	LongName     types.String                   `tfsdk:"long_name"`
+	Version      types.String                   `tfsdk:"version"`
+	URL          types.String                   `tfsdk:"url"`
+	Enabled      types.Bool                     `tfsdk:"enabled"`
+	Active       types.Bool                     `tfsdk:"active"`
+	HasUpdate    types.Bool                     `tfsdk:"has_update"`
+	Pinned       types.Bool                     `tfsdk:"pinned"`
+	Bundled      types.Bool                     `tfsdk:"bundled"`
+	Deleted      types.Bool                     `tfsdk:"deleted"`
+	Dependencies []jenkinsPluginDependencyModel `tfsdk:"dependencies"`
+}
+
+// jenkinsPluginDependencyModel describes a dependency of an installed plugin.
+type jenkinsPluginDependencyModel struct {
+	ShortName types.String `tfsdk:"short_name"`
+	Version   types.String `tfsdk:"version"`
+	Optional  types.Bool   `tfsdk:"optional"`
+}
+
+// pluginInventoryResponse mirrors the plugin manager JSON API for the fields in pluginInventoryTree.
+// gojenkins' Plugin type cannot be used, as it declares the boolean `optional` flag of dependencies as a string.
+type pluginInventoryResponse struct {
+	Plugins []struct {
+		ShortName    string `json:"shortName"`
+		LongName     string `json:"longName"`
+		Version      string `json:"version"`
+		URL          string `json:"url"`
+		Enabled      bool   `json:"enabled"`
+		Active       bool   `json:"active"`
+		HasUpdate    bool   `json:"hasUpdate"`
+		Pinned       bool   `json:"pinned"`
+		Bundled      bool   `json:"bundled"`
+		Deleted      bool   `json:"deleted"`
+		Dependencies []struct {
+			ShortName string `json:"shortName"`
+			Version   string `json:"version"`
+			Optional  bool   `json:"optional"`
+		} `json:"dependencies"`
+	} `json:"plugins"`
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsPluginsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugins" // e.g., jenkins_plugins
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsPluginsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the plugins installed on the Jenkins controller.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the lookup, always `plugins`.",
+				Computed:            true,
+			},
+			"plugins": schema.ListNestedAttribute{
+				MarkdownDescription: "The installed plugins, sorted by short name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"short_name": schema.StringAttribute{
+							MarkdownDescription: "The short name of the plugin (e.g., `git`).",
+							Computed:            true,
+						},
+						"long_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the plugin.",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The installed version of the plugin.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the plugin's website.",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the plugin is enabled.",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the plugin is loaded.",
+							Computed:            true,
+						},
+						"has_update": schema.BoolAttribute{
+							MarkdownDescription: "Whether the update center offers a newer version.",
+							Computed:            true,
+						},
+						"pinned": schema.BoolAttribute{
+							MarkdownDescription: "Whether the plugin is pinned to its version.",
+							Computed:            true,
+						},
+						"bundled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the plugin is bundled with Jenkins.",
+							Computed:            true,
+						},
+						"deleted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the plugin is uninstalled and disappears on the next restart.",
+							Computed:            true,
+						},
+						"dependencies": schema.ListNestedAttribute{
+							MarkdownDescription: "The plugins this plugin depends on.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"short_name": schema.StringAttribute{
+										MarkdownDescription: "The short name of the required plugin.",
+										Computed:            true,
+									},
+									"version": schema.StringAttribute{
+										MarkdownDescription: "The minimum version of the required plugin.",
+										Computed:            true,
+									},
+									"optional": schema.BoolAttribute{
+										MarkdownDescription: "Whether the dependency is optional.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsPluginsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// Read lists the installed plugins.
+func (d *jenkinsPluginsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsPluginsDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inventory := &pluginInventoryResponse{}
+	httpResp, err := d.client.Requester.GetJSON(ctx, "/pluginManager", inventory, map[string]string{
+		"depth": "1",
+		"tree":  pluginInventoryTree,
+	})
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Plugins Read Error",
+			fmt.Sprintf("Failed to list Jenkins plugins: %s", err.Error()),
+		)
+		return
+	}
+
+	plugins := []jenkinsPluginItemModel{}
+	for _, plugin := range inventory.Plugins {
+		dependencies := []jenkinsPluginDependencyModel{}
+		for _, dependency := range plugin.Dependencies {
+			dependencies = append(dependencies, jenkinsPluginDependencyModel{
+				ShortName: types.StringValue(dependency.ShortName),
+				Version:   types.StringValue(dependency.Version),
+				Optional:  types.BoolValue(dependency.Optional),
+			})
+		}
+		plugins = append(plugins, jenkinsPluginItemModel{
+			ShortName:    types.StringValue(plugin.ShortName),
+			LongName:     types.StringValue(plugin.LongName),
+			Version:      types.StringValue(plugin.Version),
+			URL:          types.StringValue(plugin.URL),
+			Enabled:      types.BoolValue(plugin.Enabled),
+			Active:       types.BoolValue(plugin.Active),
+			HasUpdate:    types.BoolValue(plugin.HasUpdate),
+			Pinned:       types.BoolValue(plugin.Pinned),
+			Bundled:      types.BoolValue(plugin.Bundled),
+			Deleted:      types.BoolValue(plugin.Deleted),
+			Dependencies: dependencies,
+		})
+	}
+	// Jenkins lists plugins in load order, which is not stable across restarts
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].ShortName.ValueString() < plugins[j].ShortName.ValueString()
+	})
+
+	// Update the state
+	config.ID = types.StringValue("plugins")
+	config.Plugins = plugins
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins plugins data source read successfully, %d plugin(s) found.", len(plugins))
+}
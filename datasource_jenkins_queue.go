@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected data source interfaces.
+var _ datasource.DataSource = &jenkinsQueueDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &jenkinsQueueDataSource{}
+
+// NewJenkinsQueueDataSource is a helper function to simplify provider development.
+func NewJenkinsQueueDataSource() datasource.DataSource {
+	return &jenkinsQueueDataSource{}
+}
+
+// jenkinsQueueDataSource defines the data source implementation.
+type jenkinsQueueDataSource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsQueueDataSourceModel describes the data source data model for the build queue.
+type jenkinsQueueDataSourceModel struct {
+	ID             types.String            `tfsdk:"id"`              // Identifier of the lookup (computed)
+	Job            types.String            `tfsdk:"job"`             // Full name or URL of the job to filter by
+	WaitForEmpty   types.Bool              `tfsdk:"wait_for_empty"`  // Whether to wait until no matching item is queued
+	TimeoutMinutes types.Int64             `tfsdk:"timeout_minutes"` // Maximum time to wait
+	Items          []jenkinsQueueItemModel `tfsdk:"items"`           // Queued items (computed)
+}
+
+// jenkinsQueueItemModel describes a single item of the build queue.
+type jenkinsQueueItemModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	TaskName     types.String `tfsdk:"task_name"`
+	Job          types.String `tfsdk:"job"`
+	URL          types.String `tfsdk:"url"`
+	Why          types.String `tfsdk:"why"`
+	InQueueSince types.String `tfsdk:"in_queue_since"`
+	Blocked      types.Bool   `tfsdk:"blocked"`
+	Buildable    types.Bool   `tfsdk:"buildable"`
+	Stuck        types.Bool   `tfsdk:"stuck"`
+}
+
+// Metadata returns the data source's metadata.
+func (d *jenkinsQueueDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_queue" // e.g., jenkins_queue
+}
+
+// Schema defines the data source's schema.
+func (d *jenkinsQueueDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the Jenkins build queue, optionally waiting until it is empty.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The identifier of the lookup, the job or `all`.",
+				Computed:            true,
+			},
+			"job": schema.StringAttribute{
+				MarkdownDescription: "Only return the items of this job, given by full name (e.g., `team/deploy`) or URL.",
+				Optional:            true,
+			},
+			"wait_for_empty": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait until no (matching) item is left in the queue, e.g. before a maintenance window. Defaults to `false`.",
+				Optional:            true,
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The maximum time to wait for the queue to drain with `wait_for_empty`. Defaults to `60`.",
+				Optional:            true,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "The queued items, in queue order.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the queue item.",
+							Computed:            true,
+						},
+						"task_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the queued task, usually the job name.",
+							Computed:            true,
+						},
+						"job": schema.StringAttribute{
+							MarkdownDescription: "The full name of the queued job, empty for tasks that are not jobs.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the queued task.",
+							Computed:            true,
+						},
+						"why": schema.StringAttribute{
+							MarkdownDescription: "Why the item is still waiting (e.g., `Waiting for next available executor`).",
+							Computed:            true,
+						},
+						"in_queue_since": schema.StringAttribute{
+							MarkdownDescription: "The time the item entered the queue, as an RFC 3339 timestamp.",
+							Computed:            true,
+						},
+						"blocked": schema.BoolAttribute{
+							MarkdownDescription: "Whether the item is blocked, e.g. by a running build of the same job.",
+							Computed:            true,
+						},
+						"buildable": schema.BoolAttribute{
+							MarkdownDescription: "Whether the item is waiting for an executor.",
+							Computed:            true,
+						},
+						"stuck": schema.BoolAttribute{
+							MarkdownDescription: "Whether the item has been waiting unusually long, e.g. for an offline agent.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the timeout.
+func (d *jenkinsQueueDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config jenkinsQueueDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TimeoutMinutes.IsNull() && !config.TimeoutMinutes.IsUnknown() && config.TimeoutMinutes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_minutes"), "Invalid Timeout", "'timeout_minutes' must be at least 1.")
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (d *jenkinsQueueDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	d.client = client
+}
+
+// getQueueItems returns the queued items, limited to those of a job when jobName is set.
+func getQueueItems(ctx context.Context, client *gojenkins.Jenkins, jobName string) ([]jenkinsQueueItemModel, error) {
+	queue, err := client.GetQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []jenkinsQueueItemModel{}
+	for _, task := range queue.Raw.Items {
+		// The queue API only reports task URLs, so the full name is derived from them
+		job := ""
+		if task.Task.URL != "" {
+			job = jobFullNameFromKey(task.Task.URL)
+		}
+		if jobName != "" && job != jobName {
+			continue
+		}
+		items = append(items, jenkinsQueueItemModel{
+			ID:           types.Int64Value(task.ID),
+			TaskName:     types.StringValue(task.Task.Name),
+			Job:          types.StringValue(job),
+			URL:          types.StringValue(task.Task.URL),
+			Why:          types.StringValue(task.Why),
+			InQueueSince: types.StringValue(time.UnixMilli(task.InQueueSince).UTC().Format(time.RFC3339)),
+			Blocked:      types.BoolValue(task.Blocked),
+			Buildable:    types.BoolValue(task.Buildable),
+			Stuck:        types.BoolValue(task.Stuck),
+		})
+	}
+	return items, nil
+}
+
+// Read retrieves the queue, waiting for it to drain when requested.
+func (d *jenkinsQueueDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jenkinsQueueDataSourceModel
+
+	// Get the configuration from Terraform
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := ""
+	if !config.Job.IsNull() {
+		jobName = jobFullNameFromKey(config.Job.ValueString())
+	}
+
+	var items []jenkinsQueueItemModel
+	var err error
+	if config.WaitForEmpty.ValueBool() {
+		timeout := int64(60)
+		if !config.TimeoutMinutes.IsNull() {
+			timeout = config.TimeoutMinutes.ValueInt64()
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Minute)
+		defer cancel()
+
+		err = waitForPoll(waitCtx, func() (bool, error) {
+			var err error
+			items, err = getQueueItems(waitCtx, d.client, jobName)
+			if err != nil {
+				return false, err
+			}
+			log.Printf("[DEBUG] Jenkins queue has %d matching item(s).", len(items))
+			return len(items) == 0, nil
+		})
+		if err == context.DeadlineExceeded {
+			resp.Diagnostics.AddError(
+				"Jenkins Queue Wait Timeout",
+				fmt.Sprintf("The Jenkins queue still had %d matching item(s) after %d minute(s).", len(items), timeout),
+			)
+			return
+		}
+	} else {
+		items, err = getQueueItems(ctx, d.client, jobName)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Queue Read Error",
+			fmt.Sprintf("Failed to read the Jenkins queue: %s", err.Error()),
+		)
+		return
+	}
+
+	// Update the state
+	config.ID = types.StringValue("all")
+	if jobName != "" {
+		config.ID = types.StringValue(jobName)
+	}
+	config.Items = items
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+
+	log.Printf("[INFO] Jenkins queue data source read successfully, %d item(s) found.", len(items))
+}
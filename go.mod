@@ -8,6 +8,7 @@ require (
 	github.com/bndr/gojenkins v1.1.0
 	github.com/hashicorp/terraform-plugin-framework v1.15.0
 	github.com/hashicorp/terraform-plugin-go v0.28.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// folderURL returns the API path of a job or folder given its full name, or "" for the Jenkins root.
+func folderURL(folder string) string {
+	var b strings.Builder
+	for _, segment := range strings.Split(folder, "/") {
+		if segment != "" {
+			b.WriteString("/job/" + url.PathEscape(segment))
+		}
+	}
+	return b.String()
+}
+
+// folderParents splits a folder full name into the parent IDs expected by gojenkins.
+func folderParents(folder string) []string {
+	var parents []string
+	for _, segment := range strings.Split(folder, "/") {
+		if segment != "" {
+			parents = append(parents, segment)
+		}
+	}
+	return parents
+}
+
+// splitJobFullName splits the full name of a job into its folder and name.
+func splitJobFullName(fullName string) (string, string) {
+	fullName = strings.Trim(fullName, "/")
+	if i := strings.LastIndex(fullName, "/"); i >= 0 {
+		return fullName[:i], fullName[i+1:]
+	}
+	return "", fullName
+}
+
+// optionalString converts an empty string read from Jenkins into a null value.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// escapeXML escapes a value for use as XML character data.
+func escapeXML(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+// unmarshalJenkinsXML decodes a Jenkins config.xml document. Jenkins declares XML 1.1,
+// which encoding/xml refuses, so the declaration is downgraded before decoding.
+func unmarshalJenkinsXML(data string, v interface{}) error {
+	data = strings.Replace(data, "version='1.1'", "version='1.0'", 1)
+	data = strings.Replace(data, `version="1.1"`, `version="1.0"`, 1)
+	return xml.Unmarshal([]byte(data), v)
+}
+
+// xmlElement captures the name of an arbitrary XML element.
+type xmlElement struct {
+	XMLName xml.Name
+}
+
+// normalizeXML re-encodes an XML document without the declaration, comments and
+// whitespace-only text, so that documents differing only in formatting compare equal.
+func normalizeXML(doc string) (string, error) {
+	doc = strings.Replace(doc, "version='1.1'", "version='1.0'", 1)
+	doc = strings.Replace(doc, `version="1.1"`, `version="1.0"`, 1)
+
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	var out bytes.Buffer
+	encoder := xml.NewEncoder(&out)
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+
+		switch t := token.(type) {
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) == 0 {
+				continue
+			}
+		case xml.ProcInst, xml.Comment, xml.Directive:
+			continue
+		}
+
+		if err := encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return "", err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// xmlNode is a parsed XML element, used to compare documents by content.
+type xmlNode struct {
+	Name     string
+	Attrs    map[string]string
+	Text     string
+	Children []*xmlNode
+}
+
+// parseXMLNode parses a document into its root xmlNode. Comments, processing instructions
+// and whitespace around text are dropped.
+func parseXMLNode(doc string) (*xmlNode, error) {
+	doc = strings.Replace(doc, "version='1.1'", "version='1.0'", 1)
+	doc = strings.Replace(doc, `version="1.1"`, `version="1.0"`, 1)
+
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	var stack []*xmlNode
+	var root *xmlNode
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{Name: t.Name.Local, Attrs: map[string]string{}}
+			for _, attr := range t.Attr {
+				node.Attrs[attr.Name.Local] = attr.Value
+			}
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1]
+				parent.Children = append(parent.Children, node)
+			} else {
+				root = node
+			}
+			stack = append(stack, node)
+		case xml.EndElement:
+			node := stack[len(stack)-1]
+			node.Text = strings.TrimSpace(node.Text)
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].Text += string(t)
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("the document has no root element")
+	}
+	return root, nil
+}
+
+// xmlNodeContains reports whether actual holds everything desired specifies. Jenkins does not
+// return the stored config.xml of an item but re-serializes it, so elements that only appear in
+// actual (defaults) and `plugin` attributes (which carry the installed plugin version) are
+// ignored. Desired children must appear in actual in the same order.
+func xmlNodeContains(desired, actual *xmlNode) bool {
+	if desired.Name != actual.Name {
+		return false
+	}
+	for name, value := range desired.Attrs {
+		if name == "plugin" {
+			continue
+		}
+		if actual.Attrs[name] != value {
+			return false
+		}
+	}
+	if len(desired.Children) == 0 {
+		return desired.Text == actual.Text && (desired.Text != "" || len(actual.Children) == 0)
+	}
+
+	next := 0
+	for _, child := range desired.Children {
+		found := false
+		for next < len(actual.Children) {
+			candidate := actual.Children[next]
+			next++
+			if xmlNodeContains(child, candidate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// pollInterval is how often waitForPoll checks on long-running Jenkins operations.
+const pollInterval = 5 * time.Second
+
+// waitForPoll calls poll every pollInterval until it reports done, fails or the context ends.
+func waitForPoll(ctx context.Context, poll func() (bool, error)) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		done, err := poll()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -126,12 +126,31 @@ func (p *jenkinsProvider) Configure(ctx context.Context, req provider.ConfigureR
 func (p *jenkinsProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewJenkinsPipelineResource,
+		NewJenkinsAPITokenResource,
+		NewJenkinsNodeResource,
+		NewJenkinsViewResource,
+		NewJenkinsMultibranchPipelineResource,
+		NewJenkinsOrganizationFolderResource,
+		NewJenkinsFreestyleJobResource,
+		NewJenkinsBuildResource,
+		NewJenkinsPluginResource,
+		NewJenkinsSafeRestartResource,
+		NewJenkinsCascResource,
 	}
 }
 
 func (p *jenkinsProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewJenkinsPipelineDataSource,
+		NewJenkinsNodeDataSource,
+		NewJenkinsNodesDataSource,
+		NewJenkinsBuildsDataSource,
+		NewJenkinsBuildDataSource,
+		NewJenkinsPipelineStagesDataSource,
+		NewJenkinsBuildLogDataSource,
+		NewJenkinsJobsDataSource,
+		NewJenkinsQueueDataSource,
+		NewJenkinsPluginsDataSource,
 	}
 }
 
@@ -0,0 +1,277 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// apiTokenPropertyURL is the descriptor endpoint that manages API tokens of the authenticated user.
+const apiTokenPropertyURL = "/me/descriptorByName/jenkins.security.ApiTokenProperty"
+
+// Ensure the implementation satisfies the resource.Resource interface.
+var _ resource.Resource = &jenkinsAPITokenResource{}
+
+// NewJenkinsAPITokenResource is a helper function to simplify provider development.
+func NewJenkinsAPITokenResource() resource.Resource {
+	return &jenkinsAPITokenResource{}
+}
+
+// jenkinsAPITokenResource defines the resource implementation.
+type jenkinsAPITokenResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsAPITokenResourceModel describes the resource data model for a Jenkins API token.
+type jenkinsAPITokenResourceModel struct {
+	ID         types.String `tfsdk:"id"`          // Token UUID assigned by Jenkins
+	Name       types.String `tfsdk:"name"`        // Display name of the token
+	Username   types.String `tfsdk:"username"`    // User owning the token (computed)
+	TokenValue types.String `tfsdk:"token_value"` // Secret token value, only returned on creation (computed)
+}
+
+// generateTokenResponse mirrors the JSON returned by ApiTokenProperty/generateNewToken.
+type generateTokenResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		TokenName  string `json:"tokenName"`
+		TokenUUID  string `json:"tokenUuid"`
+		TokenValue string `json:"tokenValue"`
+	} `json:"data"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsAPITokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token" // e.g., jenkins_api_token
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsAPITokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a long-lived API token for the user the provider authenticates as.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The UUID Jenkins assigned to the token.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the API token, as shown on the user's configuration page.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // A new token is generated for a new name
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The Jenkins user that owns the token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_value": schema.StringAttribute{
+				MarkdownDescription: "The secret token value. Jenkins only reveals it once, at creation time.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsAPITokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// providerUsername returns the username the Jenkins client authenticates with.
+func providerUsername(client *gojenkins.Jenkins) string {
+	if client.Requester.BasicAuth == nil {
+		return ""
+	}
+	return client.Requester.BasicAuth.Username
+}
+
+// errAPITokenListUnavailable is returned when none of the user's pages shows the token list,
+// e.g. for lack of permission or on Jenkins versions with a different layout.
+var errAPITokenListUnavailable = errors.New("the API token list is not available")
+
+// apiTokenListPages are the user pages that render the token list, in order of preference:
+// the configuration page of older Jenkins versions and the Security page of recent ones.
+var apiTokenListPages = []string{"configure", "security"}
+
+// apiTokenExists reports whether the token with the given UUID is still in the user's token list.
+// Jenkins does not export the token list over the REST API, so it is read from the user's
+// pages, which render the UUID of every token of the ApiTokenProperty.
+func apiTokenExists(ctx context.Context, client *gojenkins.Jenkins, username, tokenUUID string) (bool, error) {
+	for _, name := range apiTokenListPages {
+		var page string
+		httpResp, err := client.Requester.Get(ctx, "/user/"+url.PathEscape(username)+"/"+name, &page, nil)
+		if err != nil {
+			return false, err
+		}
+		if httpResp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if httpResp.StatusCode != http.StatusOK {
+			return false, fmt.Errorf("unexpected status code %d", httpResp.StatusCode)
+		}
+		// Without the token section the page says nothing about the token
+		if strings.Contains(page, "jenkins.security.ApiTokenProperty") {
+			return strings.Contains(page, tokenUUID), nil
+		}
+	}
+	return false, errAPITokenListUnavailable
+}
+
+// Create generates a new API token.
+func (r *jenkinsAPITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsAPITokenResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenName := plan.Name.ValueString()
+
+	var generated generateTokenResponse
+	httpResp, err := r.client.Requester.Post(ctx, apiTokenPropertyURL+"/generateNewToken", nil, &generated, map[string]string{
+		"newTokenName": tokenName,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins API Token Creation Error",
+			fmt.Sprintf("Failed to generate API token '%s': %s", tokenName, err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK || generated.Data.TokenUUID == "" {
+		resp.Diagnostics.AddError(
+			"Jenkins API Token Creation Error",
+			fmt.Sprintf("Failed to generate API token '%s': Jenkins responded with status %d", tokenName, httpResp.StatusCode),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(generated.Data.TokenUUID)
+	plan.Name = types.StringValue(generated.Data.TokenName)
+	plan.Username = types.StringValue(providerUsername(r.client))
+	plan.TokenValue = types.StringValue(generated.Data.TokenValue)
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins API token '%s' created successfully.", tokenName)
+}
+
+// Read checks whether the API token has been revoked.
+func (r *jenkinsAPITokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsAPITokenResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenName := state.Name.ValueString()
+
+	exists, err := apiTokenExists(ctx, r.client, state.Username.ValueString(), state.ID.ValueString())
+	if errors.Is(err, errAPITokenListUnavailable) {
+		// Revocation cannot be detected, keep the recorded token rather than failing the refresh
+		log.Printf("[WARN] The API token list of user '%s' is not available, keeping API token '%s' in state.", state.Username.ValueString(), tokenName)
+		exists, err = true, nil
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins API Token Read Error",
+			fmt.Sprintf("Failed to verify API token '%s': %s", tokenName, err.Error()),
+		)
+		return
+	}
+	if !exists {
+		// Token was revoked outside of Terraform, remove it from state
+		resp.State.RemoveResource(ctx)
+		log.Printf("[INFO] Jenkins API token '%s' has been revoked, removing from state.", tokenName)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins API token '%s' read successfully.", tokenName)
+}
+
+// Update is a no-op, every configurable attribute forces replacement.
+func (r *jenkinsAPITokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsAPITokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete revokes the API token.
+func (r *jenkinsAPITokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsAPITokenResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenName := state.Name.ValueString()
+
+	httpResp, err := r.client.Requester.Post(ctx, apiTokenPropertyURL+"/revoke", nil, nil, map[string]string{
+		"tokenUuid": state.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins API Token Revocation Error",
+			fmt.Sprintf("Failed to revoke API token '%s': %s", tokenName, err.Error()),
+		)
+		return
+	}
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError(
+			"Jenkins API Token Revocation Error",
+			fmt.Sprintf("Failed to revoke API token '%s': Jenkins responded with status %d", tokenName, httpResp.StatusCode),
+		)
+		return
+	}
+
+	log.Printf("[INFO] Jenkins API token '%s' revoked successfully.", tokenName)
+}
@@ -0,0 +1,520 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// errBuildNotFound is returned when a build does not exist (anymore).
+var errBuildNotFound = errors.New("build not found")
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsBuildResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsBuildResource{}
+
+// NewJenkinsBuildResource is a helper function to simplify provider development.
+func NewJenkinsBuildResource() resource.Resource {
+	return &jenkinsBuildResource{}
+}
+
+// jenkinsBuildResource defines the resource implementation.
+type jenkinsBuildResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsBuildResourceModel describes the resource data model for a triggered build.
+type jenkinsBuildResourceModel struct {
+	ID                types.String `tfsdk:"id"`                  // Unique identifier (job full name and build number)
+	Job               types.String `tfsdk:"job"`                 // Full name of the job to build
+	Parameters        types.Map    `tfsdk:"parameters"`          // Build parameters
+	Triggers          types.Map    `tfsdk:"triggers"`            // Arbitrary values that re-trigger the build when changed
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"` // Whether to wait for the build to finish
+	TimeoutMinutes    types.Int64  `tfsdk:"timeout_minutes"`     // Maximum time to wait
+	FailOnResult      types.Bool   `tfsdk:"fail_on_result"`      // Whether to fail the apply on an unexpected result
+	AllowedResults    types.Set    `tfsdk:"allowed_results"`     // Results that do not fail the apply
+	LogLines          types.Int64  `tfsdk:"log_lines"`           // Console lines included in the failure diagnostic
+	QueueID           types.Int64  `tfsdk:"queue_id"`            // ID of the queue item (computed)
+	Number            types.Int64  `tfsdk:"number"`              // Build number (computed)
+	URL               types.String `tfsdk:"url"`                 // Build URL (computed)
+	Result            types.String `tfsdk:"result"`              // Build result, empty while running (computed)
+}
+
+// queueItemResponse mirrors the queue item JSON API.
+type queueItemResponse struct {
+	Cancelled  bool   `json:"cancelled"`
+	Why        string `json:"why"`
+	Executable *struct {
+		Number int64  `json:"number"`
+		URL    string `json:"url"`
+	} `json:"executable"`
+}
+
+// buildStatusResponse mirrors the build JSON API restricted to its status.
+type buildStatusResponse struct {
+	Number   int64  `json:"number"`
+	URL      string `json:"url"`
+	Result   string `json:"result"`
+	Building bool   `json:"building"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsBuildResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build" // e.g., jenkins_build
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsBuildResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Triggers a build of a Jenkins job. A new build is triggered whenever `job`, `parameters` or `triggers` change. Destroying the resource does not delete the build.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The full name of the job and the build number, separated by `#`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job": schema.StringAttribute{
+				MarkdownDescription: "The full name of the job to build, including its folder (e.g., `team/seed`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parameters": schema.MapAttribute{
+				MarkdownDescription: "The build parameters. Parameters not set use their default value.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new build when they change (e.g., the ID of a resource the job deploys).",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the build to finish. Otherwise the apply completes once the build has started. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The maximum time to wait for the build to start and, with `wait_for_completion`, to finish. Defaults to `60`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(60),
+			},
+			"fail_on_result": schema.BoolAttribute{
+				MarkdownDescription: "Whether the apply fails when the build result is not in `allowed_results`. The failed build is still recorded in state, but the resource is marked as tainted, so the next apply replaces it with a new build. Requires `wait_for_completion`. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allowed_results": schema.SetAttribute{
+				MarkdownDescription: "The results accepted by `fail_on_result` (e.g., `SUCCESS`, `UNSTABLE`). Defaults to `SUCCESS` only.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"log_lines": schema.Int64Attribute{
+				MarkdownDescription: "The number of trailing console log lines included in the error when `fail_on_result` fails the apply. Defaults to `50`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(50),
+			},
+			"queue_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the queue item created by the trigger.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"number": schema.Int64Attribute{
+				MarkdownDescription: "The number of the build.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the build.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result": schema.StringAttribute{
+				MarkdownDescription: "The result of the build (e.g., `SUCCESS`, `FAILURE`), empty while it is running.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that failing on the build result is only requested when waiting for it.
+func (r *jenkinsBuildResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsBuildResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.FailOnResult.ValueBool() && !config.WaitForCompletion.IsUnknown() && !config.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fail_on_result"),
+			"Missing Wait For Completion",
+			"'fail_on_result' requires 'wait_for_completion' to be true, otherwise the result is not known during apply.",
+		)
+	}
+	if !config.AllowedResults.IsNull() && !config.FailOnResult.IsUnknown() && !config.FailOnResult.ValueBool() {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("allowed_results"),
+			"Allowed Results Ignored",
+			"'allowed_results' only has an effect when 'fail_on_result' is true.",
+		)
+	}
+	if !config.LogLines.IsNull() && !config.LogLines.IsUnknown() && config.LogLines.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("log_lines"), "Invalid Log Lines", "'log_lines' must not be negative.")
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsBuildResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// triggerBuild queues a build of a job and returns the ID of the queue item.
+//
+// This mirrors gojenkins' Job.InvokeSimple, which cannot address jobs in folders by full
+// name and refuses to trigger jobs that are already queued.
+func triggerBuild(ctx context.Context, client *gojenkins.Jenkins, fullName string, params map[string]string) (int64, error) {
+	folder, name := splitJobFullName(fullName)
+	job, err := client.GetJob(ctx, name, folderParents(folder)...)
+	if err != nil {
+		return 0, err
+	}
+
+	endpoint := "/build"
+	definitions, err := job.GetParameters(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(definitions) > 0 || len(params) > 0 {
+		endpoint = "/buildWithParameters"
+	}
+
+	data := url.Values{}
+	for k, v := range params {
+		data.Set(k, v)
+	}
+	httpResp, err := client.Requester.Post(ctx, folderURL(fullName)+endpoint, strings.NewReader(data.Encode()), nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusCreated {
+		return 0, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+
+	// The queue item is returned as e.g. https://jenkins/queue/item/42/
+	location := strings.TrimSuffix(httpResp.Header.Get("Location"), "/")
+	queueID, err := strconv.ParseInt(location[strings.LastIndex(location, "/")+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not determine the queue item from location '%s'", location)
+	}
+	return queueID, nil
+}
+
+// waitForQueueItem waits until a queue item becomes a build, and returns the build number.
+func waitForQueueItem(ctx context.Context, client *gojenkins.Jenkins, queueID int64) (int64, error) {
+	var number int64
+	err := waitForPoll(ctx, func() (bool, error) {
+		item := &queueItemResponse{}
+		httpResp, err := client.Requester.GetJSON(ctx, fmt.Sprintf("/queue/item/%d", queueID), item, nil)
+		if err != nil {
+			return false, err
+		}
+		if httpResp.StatusCode != http.StatusOK {
+			return false, fmt.Errorf("queue item %d: Jenkins responded with status %d", queueID, httpResp.StatusCode)
+		}
+		if item.Cancelled {
+			return false, fmt.Errorf("queue item %d was cancelled", queueID)
+		}
+		if item.Executable != nil {
+			number = item.Executable.Number
+			return true, nil
+		}
+		log.Printf("[DEBUG] Queue item %d is waiting: %s", queueID, item.Why)
+		return false, nil
+	})
+	return number, err
+}
+
+// getBuildStatus retrieves the status of a build.
+func getBuildStatus(ctx context.Context, client *gojenkins.Jenkins, fullName string, number int64) (*buildStatusResponse, error) {
+	build := &buildStatusResponse{}
+	httpResp, err := client.Requester.GetJSON(ctx, fmt.Sprintf("%s/%d", folderURL(fullName), number), build, map[string]string{
+		"tree": "number,url,result,building",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, errBuildNotFound
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return build, nil
+}
+
+// waitForBuild waits until a build has finished, and returns its final status.
+func waitForBuild(ctx context.Context, client *gojenkins.Jenkins, fullName string, number int64) (*buildStatusResponse, error) {
+	var build *buildStatusResponse
+	err := waitForPoll(ctx, func() (bool, error) {
+		var err error
+		build, err = getBuildStatus(ctx, client, fullName, number)
+		if err != nil {
+			return false, err
+		}
+		return !build.Building, nil
+	})
+	return build, err
+}
+
+// consoleTail returns the last `lines` lines of the console log of a build.
+func consoleTail(ctx context.Context, client *gojenkins.Jenkins, fullName string, number int64, lines int) (string, error) {
+	var raw string
+	httpResp, err := client.Requester.Get(ctx, fmt.Sprintf("%s/%d/consoleText", folderURL(fullName), number), &raw, nil)
+	if err != nil {
+		return "", err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+
+	all := strings.Split(strings.TrimRight(raw, "\n"), "\n")
+	if len(all) > lines {
+		all = all[len(all)-lines:]
+	}
+	return strings.Join(all, "\n"), nil
+}
+
+// applyBuildStatus copies the status of a build into the resource model.
+func applyBuildStatus(build *buildStatusResponse, model *jenkinsBuildResourceModel) {
+	model.ID = types.StringValue(fmt.Sprintf("%s#%d", model.Job.ValueString(), build.Number))
+	model.Number = types.Int64Value(build.Number)
+	model.URL = types.StringValue(build.URL)
+	model.Result = types.StringValue(build.Result)
+}
+
+// setPartialBuildState records a build whose creation failed after it was queued. Terraform
+// then taints the resource instead of forgetting the build, and the known identifiers stay
+// available; fields that are not known yet are stored as empty values.
+func setPartialBuildState(ctx context.Context, model *jenkinsBuildResourceModel, number int64, resp *resource.CreateResponse) {
+	if number > 0 {
+		model.ID = types.StringValue(fmt.Sprintf("%s#%d", model.Job.ValueString(), number))
+	} else {
+		model.ID = types.StringValue(fmt.Sprintf("%s@queue-%d", model.Job.ValueString(), model.QueueID.ValueInt64()))
+	}
+	model.Number = types.Int64Value(number)
+	if model.URL.IsUnknown() {
+		model.URL = types.StringValue("")
+	}
+	if model.Result.IsUnknown() {
+		model.Result = types.StringValue("")
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Create triggers a new build.
+func (r *jenkinsBuildResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsBuildResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := plan.Job.ValueString()
+	params := map[string]string{}
+	if !plan.Parameters.IsNull() {
+		resp.Diagnostics.Append(plan.Parameters.ElementsAs(ctx, &params, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(plan.TimeoutMinutes.ValueInt64())*time.Minute)
+	defer cancel()
+
+	queueID, err := triggerBuild(ctx, r.client, jobName, params)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Trigger Error",
+			fmt.Sprintf("Failed to trigger a build of job '%s': %s", jobName, err.Error()),
+		)
+		return
+	}
+	plan.QueueID = types.Int64Value(queueID)
+
+	number, err := waitForQueueItem(ctx, r.client, queueID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Start Error",
+			fmt.Sprintf("Build of job '%s' did not start: %s", jobName, err.Error()),
+		)
+		setPartialBuildState(ctx, &plan, 0, resp)
+		return
+	}
+
+	var build *buildStatusResponse
+	if plan.WaitForCompletion.ValueBool() {
+		build, err = waitForBuild(ctx, r.client, jobName, number)
+	} else {
+		build, err = getBuildStatus(ctx, r.client, jobName, number)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Build Read Error",
+			fmt.Sprintf("Failed to follow build #%d of job '%s': %s", number, jobName, err.Error()),
+		)
+		setPartialBuildState(ctx, &plan, number, resp)
+		return
+	}
+	applyBuildStatus(build, &plan)
+
+	if plan.FailOnResult.ValueBool() {
+		allowed := []string{"SUCCESS"}
+		if !plan.AllowedResults.IsNull() {
+			resp.Diagnostics.Append(plan.AllowedResults.ElementsAs(ctx, &allowed, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		accepted := false
+		for _, result := range allowed {
+			if strings.EqualFold(result, build.Result) {
+				accepted = true
+			}
+		}
+		if !accepted {
+			detail := fmt.Sprintf("Build %s finished with result %s, expected one of: %s.", build.URL, build.Result, strings.Join(allowed, ", "))
+			if lines := plan.LogLines.ValueInt64(); lines > 0 {
+				tail, err := consoleTail(ctx, r.client, jobName, number, int(lines))
+				if err != nil {
+					detail += fmt.Sprintf("\n\nThe console log could not be retrieved: %s", err.Error())
+				} else {
+					detail += fmt.Sprintf("\n\nLast %d lines of the console log:\n\n%s", lines, tail)
+				}
+			}
+			resp.Diagnostics.AddError("Jenkins Build Failed", detail)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+			return
+		}
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins build '%s' triggered successfully.", plan.ID.ValueString())
+}
+
+// Read refreshes the status of the build.
+func (r *jenkinsBuildResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsBuildResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	build, err := getBuildStatus(ctx, r.client, state.Job.ValueString(), state.Number.ValueInt64())
+	if err != nil {
+		if errors.Is(err, errBuildNotFound) {
+			// Builds are routinely discarded by retention policies; that is no reason to build again.
+			log.Printf("[INFO] Jenkins build '%s' no longer exists, keeping the recorded state.", state.ID.ValueString())
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins Build Read Error",
+			fmt.Sprintf("Failed to get Jenkins build '%s': %s", state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	applyBuildStatus(build, &state)
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins build '%s' read successfully.", state.ID.ValueString())
+}
+
+// Update only records changes of the waiting options, which do not trigger a new build.
+func (r *jenkinsBuildResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsBuildResourceModel
+	var state jenkinsBuildResourceModel
+
+	// Get the plan (desired state) and current state from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Result = state.Result
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the build from Terraform state. The build itself is kept in Jenkins.
+func (r *jenkinsBuildResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsBuildResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[INFO] Jenkins build '%s' removed from state; the build is kept in Jenkins.", state.ID.ValueString())
+}
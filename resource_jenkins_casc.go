@@ -0,0 +1,427 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+// cascURL is the root of the Configuration as Code plugin's endpoints.
+const cascURL = "/configuration-as-code"
+
+// cascSecretPattern matches secrets as exported by Jenkins, encrypted and wrapped in braces.
+var cascSecretPattern = regexp.MustCompile(`^\{[A-Za-z0-9+/=]+\}$`)
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsCascResource{}
+var _ resource.ResourceWithImportState = &jenkinsCascResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsCascResource{}
+
+// NewJenkinsCascResource is a helper function to simplify provider development.
+func NewJenkinsCascResource() resource.Resource {
+	return &jenkinsCascResource{}
+}
+
+// jenkinsCascResource defines the resource implementation.
+type jenkinsCascResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsCascResourceModel describes the resource data model for a Configuration as Code document.
+type jenkinsCascResourceModel struct {
+	ID   types.String `tfsdk:"id"`   // Unique identifier (always casc)
+	YAML types.String `tfsdk:"yaml"` // Configuration as Code YAML document
+}
+
+// cascCheckWarning mirrors an entry of the JSON returned by /configuration-as-code/check.
+type cascCheckWarning struct {
+	Line    int    `json:"line"`
+	Warning string `json:"warning"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsCascResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_casc" // e.g., jenkins_casc
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsCascResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies a Jenkins Configuration as Code (JCasC) YAML document. Requires the configuration-as-code plugin.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The identifier of the resource, always `casc`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"yaml": schema.StringAttribute{
+				MarkdownDescription: "The YAML document to apply, e.g. `file(\"jenkins.yaml\")`. It is validated before being applied; any issue reported by Jenkins fails the apply. " +
+					"Drift is detected by comparing the settings it contains with the configuration exported by Jenkins; " +
+					"values using `${...}` variables and encrypted secrets are not compared, and zero values (e.g. `false`) match settings Jenkins leaves out of the export. " +
+					"Removing settings from the document does not reset them in Jenkins.",
+				Required:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the document is valid YAML.
+func (r *jenkinsCascResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsCascResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.YAML.IsNull() && !config.YAML.IsUnknown() {
+		var document interface{}
+		if err := yaml.Unmarshal([]byte(config.YAML.ValueString()), &document); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("yaml"), "Invalid YAML", fmt.Sprintf("'yaml' is not a valid YAML document: %s", err.Error()))
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsCascResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// postCasc posts a YAML document, or nothing, to a Configuration as Code endpoint and returns the response body.
+// The document is sent as the raw request body; a form content type would let Jenkins consume it as parameters.
+func postCasc(ctx context.Context, client *gojenkins.Jenkins, action, document string) (string, error) {
+	ar := gojenkins.NewAPIRequest("POST", cascURL+"/"+action, strings.NewReader(document))
+	if err := client.Requester.SetCrumb(ctx, ar); err != nil {
+		return "", err
+	}
+	ar.SetHeader("Content-Type", "application/x-yaml")
+
+	var body string
+	httpResp, err := client.Requester.Do(ctx, ar, &body)
+	if err != nil {
+		return "", err
+	}
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+		return body, nil
+	case http.StatusNotFound:
+		return "", fmt.Errorf("the configuration-as-code plugin is not installed")
+	default:
+		return "", fmt.Errorf("Jenkins responded with status %d: %s", httpResp.StatusCode, strings.TrimSpace(body))
+	}
+}
+
+// checkAndApplyCasc validates a document with /check and applies it only when no issue is reported.
+// Jenkins reports unknown attributes and invalid values as warnings, which would otherwise be
+// skipped silently by /apply.
+func checkAndApplyCasc(ctx context.Context, client *gojenkins.Jenkins, document string, diags *diag.Diagnostics) {
+	body, err := postCasc(ctx, client, "check", document)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("yaml"),
+			"Jenkins Configuration as Code Validation Error",
+			fmt.Sprintf("Jenkins rejected the configuration: %s", err.Error()),
+		)
+		return
+	}
+	var warnings []cascCheckWarning
+	if err := json.Unmarshal([]byte(body), &warnings); err != nil {
+		diags.AddAttributeError(
+			path.Root("yaml"),
+			"Jenkins Configuration as Code Validation Error",
+			fmt.Sprintf("Could not parse the validation result: %s", err.Error()),
+		)
+		return
+	}
+	for _, warning := range warnings {
+		diags.AddAttributeError(
+			path.Root("yaml"),
+			"Jenkins Configuration as Code Validation Error",
+			fmt.Sprintf("Line %d: %s", warning.Line, warning.Warning),
+		)
+	}
+	if len(warnings) > 0 {
+		return
+	}
+
+	if _, err := postCasc(ctx, client, "apply", document); err != nil {
+		diags.AddError(
+			"Jenkins Configuration as Code Apply Error",
+			fmt.Sprintf("Failed to apply the configuration: %s", err.Error()),
+		)
+	}
+}
+
+// cascIsDefault reports whether a desired value is a zero value (false, 0, an empty string or
+// collection), which Jenkins leaves out of the export when a setting has its default value.
+func cascIsDefault(desired interface{}) bool {
+	switch desired := desired.(type) {
+	case nil:
+		return true
+	case bool:
+		return !desired
+	case int:
+		return desired == 0
+	case float64:
+		return desired == 0
+	case string:
+		return desired == ""
+	case []interface{}:
+		return len(desired) == 0
+	case map[string]interface{}:
+		for _, value := range desired {
+			if !cascIsDefault(value) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// cascValueMatches reports whether the exported configuration contains every setting of the desired one.
+// Lists match when each desired element matches some exported element, as Jenkins may export more entries.
+// Settings missing from the export match when the desired value is a zero value, as defaults are not
+// exported; a non-zero default (e.g. `numExecutors: 2`) that is missing from the export is reported as drift.
+func cascValueMatches(desired, actual interface{}) bool {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		exportedMap, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual == nil && cascIsDefault(desired)
+		}
+		for key, value := range desired {
+			exported, ok := exportedMap[key]
+			if !ok && cascIsDefault(value) {
+				continue
+			}
+			if !cascValueMatches(value, exported) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok {
+			return false
+		}
+		for _, value := range desired {
+			if cascFindMatch(value, actual) == nil {
+				return false
+			}
+		}
+		return true
+	case string:
+		// Variables are resolved by Jenkins and secrets are exported encrypted, neither can be compared
+		if strings.Contains(desired, "${") {
+			return true
+		}
+		if secret, ok := actual.(string); ok && cascSecretPattern.MatchString(secret) && !cascSecretPattern.MatchString(desired) {
+			return true
+		}
+		return actual != nil && desired == fmt.Sprint(actual)
+	case nil:
+		return actual == nil
+	default:
+		// Numbers and booleans are sometimes exported as strings
+		return actual != nil && fmt.Sprint(desired) == fmt.Sprint(actual)
+	}
+}
+
+// cascFindMatch returns a pointer to the first element of actual that matches desired, or nil.
+func cascFindMatch(desired interface{}, actual []interface{}) *interface{} {
+	for i := range actual {
+		if cascValueMatches(desired, actual[i]) {
+			return &actual[i]
+		}
+	}
+	return nil
+}
+
+// cascProject restricts the exported configuration to the settings of the desired one, so that a
+// drifted document can be stored in state and shown as a diff against the configuration.
+func cascProject(desired, actual interface{}) interface{} {
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return actual
+		}
+		projected := map[string]interface{}{}
+		for key, value := range desired {
+			if exported, ok := actual[key]; ok {
+				projected[key] = cascProject(value, exported)
+			} else if cascIsDefault(value) {
+				projected[key] = value // Defaults are not exported
+			}
+		}
+		return projected
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok {
+			return actual
+		}
+		projected := []interface{}{}
+		for _, value := range desired {
+			if match := cascFindMatch(value, actual); match != nil {
+				projected = append(projected, cascProject(value, *match))
+			}
+		}
+		return projected
+	default:
+		if cascValueMatches(desired, actual) {
+			return desired
+		}
+		return actual
+	}
+}
+
+// Create validates and applies the document.
+func (r *jenkinsCascResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsCascResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkAndApplyCasc(ctx, r.client, plan.YAML.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("casc")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins Configuration as Code applied successfully.")
+}
+
+// Read compares the document in state with the configuration exported by Jenkins.
+func (r *jenkinsCascResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsCascResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exported, err := postCasc(ctx, r.client, "export", "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Configuration as Code Export Error",
+			fmt.Sprintf("Failed to export the Jenkins configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	// Imported resources adopt the whole exported configuration
+	if state.YAML.IsNull() {
+		state.ID = types.StringValue("casc")
+		state.YAML = types.StringValue(exported)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	var desired, actual interface{}
+	if err := yaml.Unmarshal([]byte(state.YAML.ValueString()), &desired); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Configuration as Code Read Error",
+			fmt.Sprintf("Failed to parse the applied configuration: %s", err.Error()),
+		)
+		return
+	}
+	if err := yaml.Unmarshal([]byte(exported), &actual); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Configuration as Code Read Error",
+			fmt.Sprintf("Failed to parse the exported configuration: %s", err.Error()),
+		)
+		return
+	}
+
+	if !cascValueMatches(desired, actual) {
+		log.Printf("[INFO] Jenkins configuration differs from the applied Configuration as Code document.")
+		projected, err := yaml.Marshal(cascProject(desired, actual))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Jenkins Configuration as Code Read Error",
+				fmt.Sprintf("Failed to render the exported configuration: %s", err.Error()),
+			)
+			return
+		}
+		state.YAML = types.StringValue(string(projected))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update validates and applies the changed document.
+func (r *jenkinsCascResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsCascResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkAndApplyCasc(ctx, r.client, plan.YAML.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue("casc")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins Configuration as Code updated successfully.")
+}
+
+// Delete removes the document from Terraform state. Applied settings cannot be reverted and stay in Jenkins.
+func (r *jenkinsCascResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsCascResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[INFO] Jenkins Configuration as Code removed from state; the applied settings are kept in Jenkins.")
+}
+
+// ImportState allows adopting the current Jenkins configuration into Terraform state.
+func (r *jenkinsCascResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Any ID can be used; the whole exported configuration is read into `yaml`.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,1140 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsFreestyleJobResource{}
+var _ resource.ResourceWithImportState = &jenkinsFreestyleJobResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsFreestyleJobResource{}
+
+// buildStepClasses maps the `type` of a build step to its builder class.
+var buildStepClasses = map[string]string{
+	"shell": "hudson.tasks.Shell",
+	"batch": "hudson.tasks.BatchFile",
+}
+
+// parameterClasses maps the `type` of a build parameter to its definition class.
+var parameterClasses = map[string]string{
+	"string":  "hudson.model.StringParameterDefinition",
+	"text":    "hudson.model.TextParameterDefinition",
+	"boolean": "hudson.model.BooleanParameterDefinition",
+	"choice":  "hudson.model.ChoiceParameterDefinition",
+}
+
+// Publisher elements managed by typed attributes.
+const (
+	artifactArchiverClass = "hudson.tasks.ArtifactArchiver"
+	junitArchiverClass    = "hudson.tasks.junit.JUnitResultArchiver"
+	mailerClass           = "hudson.tasks.Mailer"
+)
+
+// NewJenkinsFreestyleJobResource is a helper function to simplify provider development.
+func NewJenkinsFreestyleJobResource() resource.Resource {
+	return &jenkinsFreestyleJobResource{}
+}
+
+// jenkinsFreestyleJobResource defines the resource implementation.
+type jenkinsFreestyleJobResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsFreestyleJobResourceModel describes the resource data model for a freestyle job.
+type jenkinsFreestyleJobResourceModel struct {
+	ID                 types.String              `tfsdk:"id"`                   // Unique identifier (full job name)
+	Name               types.String              `tfsdk:"name"`                 // Name of the job
+	Folder             types.String              `tfsdk:"folder"`               // Full name of the folder containing the job
+	Description        types.String              `tfsdk:"description"`          // Description of the job
+	Disabled           types.Bool                `tfsdk:"disabled"`             // Whether new builds are prevented
+	ConcurrentBuild    types.Bool                `tfsdk:"concurrent_build"`     // Whether builds may run concurrently
+	AssignedNode       types.String              `tfsdk:"assigned_node"`        // Label expression restricting where builds run
+	SCM                *freestyleSCMModel        `tfsdk:"scm"`                  // Git repository checked out by builds
+	SCMXML             types.String              `tfsdk:"scm_xml"`              // Raw XML of an SCM not supported by scm
+	Cron               types.String              `tfsdk:"cron"`                 // Schedule of periodic builds
+	PollSCM            types.String              `tfsdk:"poll_scm"`             // Schedule of SCM polling
+	ExtraTriggersXML   types.String              `tfsdk:"extra_triggers_xml"`   // Raw XML of additional triggers
+	Parameters         []freestyleParameterModel `tfsdk:"parameters"`           // Build parameters
+	ExtraParametersXML types.String              `tfsdk:"extra_parameters_xml"` // Raw XML of additional parameter definitions
+	BuildSteps         []freestyleBuildStepModel `tfsdk:"build_steps"`          // Shell and batch build steps
+	ExtraBuildersXML   types.String              `tfsdk:"extra_builders_xml"`   // Raw XML of additional build steps
+	ArchiveArtifacts   *archiveArtifactsModel    `tfsdk:"archive_artifacts"`    // Artifact archiving publisher
+	JUnit              *junitPublisherModel      `tfsdk:"junit"`                // JUnit test result publisher
+	Email              *emailNotificationModel   `tfsdk:"email"`                // E-mail notification publisher
+	ExtraPublishersXML types.String              `tfsdk:"extra_publishers_xml"` // Raw XML of additional publishers
+}
+
+// freestyleSCMModel describes the Git repository of a freestyle job.
+type freestyleSCMModel struct {
+	URL           types.String `tfsdk:"url"`
+	CredentialsID types.String `tfsdk:"credentials_id"`
+	Branch        types.String `tfsdk:"branch"`
+}
+
+// freestyleParameterModel describes a build parameter.
+type freestyleParameterModel struct {
+	Name         types.String `tfsdk:"name"`
+	Type         types.String `tfsdk:"type"`
+	Description  types.String `tfsdk:"description"`
+	DefaultValue types.String `tfsdk:"default_value"`
+	Choices      []string     `tfsdk:"choices"`
+}
+
+// freestyleBuildStepModel describes a shell or batch build step.
+type freestyleBuildStepModel struct {
+	Type    types.String `tfsdk:"type"`
+	Command types.String `tfsdk:"command"`
+}
+
+// archiveArtifactsModel describes the artifact archiving publisher.
+type archiveArtifactsModel struct {
+	Artifacts        types.String `tfsdk:"artifacts"`
+	AllowEmpty       types.Bool   `tfsdk:"allow_empty"`
+	OnlyIfSuccessful types.Bool   `tfsdk:"only_if_successful"`
+	Fingerprint      types.Bool   `tfsdk:"fingerprint"`
+}
+
+// junitPublisherModel describes the JUnit test result publisher.
+type junitPublisherModel struct {
+	TestResults       types.String `tfsdk:"test_results"`
+	AllowEmptyResults types.Bool   `tfsdk:"allow_empty_results"`
+}
+
+// emailNotificationModel describes the e-mail notification publisher.
+type emailNotificationModel struct {
+	Recipients               types.String `tfsdk:"recipients"`
+	NotifyEveryUnstableBuild types.Bool   `tfsdk:"notify_every_unstable_build"`
+	SendToIndividuals        types.Bool   `tfsdk:"send_to_individuals"`
+}
+
+// rawXMLElement captures an XML element verbatim, so that it can be written back unchanged.
+type rawXMLElement struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Inner   string     `xml:",innerxml"`
+}
+
+// freestyleConfig mirrors the parts of a freestyle project config.xml managed by this provider.
+type freestyleConfig struct {
+	XMLName      xml.Name
+	Description  string         `xml:"description"`
+	Disabled     bool           `xml:"disabled"`
+	Concurrent   bool           `xml:"concurrentBuild"`
+	AssignedNode string         `xml:"assignedNode"`
+	SCM          *rawXMLElement `xml:"scm"`
+	Triggers     struct {
+		Items []rawXMLElement `xml:",any"`
+	} `xml:"triggers"`
+	Parameters struct {
+		Items []rawXMLElement `xml:",any"`
+	} `xml:"properties>hudson.model.ParametersDefinitionProperty>parameterDefinitions"`
+	Builders struct {
+		Items []rawXMLElement `xml:",any"`
+	} `xml:"builders"`
+	Publishers struct {
+		Items []rawXMLElement `xml:",any"`
+	} `xml:"publishers"`
+}
+
+// gitSCMConfig mirrors the Git settings of a freestyle project managed by the `scm` attribute.
+type gitSCMConfig struct {
+	Remotes []struct {
+		URL           string `xml:"url"`
+		CredentialsID string `xml:"credentialsId"`
+	} `xml:"userRemoteConfigs>hudson.plugins.git.UserRemoteConfig"`
+	Branches []string `xml:"branches>hudson.plugins.git.BranchSpec>name"`
+}
+
+// Trigger elements managed by typed attributes.
+const (
+	timerTriggerClass = "hudson.triggers.TimerTrigger"
+	scmTriggerClass   = "hudson.triggers.SCMTrigger"
+)
+
+// parameterConfig mirrors a parameter definition in config.xml.
+type parameterConfig struct {
+	Name         string   `xml:"name"`
+	Description  string   `xml:"description"`
+	DefaultValue string   `xml:"defaultValue"`
+	ChoiceArray  []string `xml:"choices>a>string"`
+	ChoiceList   []string `xml:"choices>string"`
+}
+
+// publisherConfig mirrors the settings of the publishers managed by typed attributes.
+type publisherConfig struct {
+	Artifacts                    string `xml:"artifacts"`
+	AllowEmptyArchive            bool   `xml:"allowEmptyArchive"`
+	OnlyIfSuccessful             bool   `xml:"onlyIfSuccessful"`
+	Fingerprint                  bool   `xml:"fingerprint"`
+	TestResults                  string `xml:"testResults"`
+	AllowEmptyResults            bool   `xml:"allowEmptyResults"`
+	Recipients                   string `xml:"recipients"`
+	DontNotifyEveryUnstableBuild bool   `xml:"dontNotifyEveryUnstableBuild"`
+	SendToIndividuals            bool   `xml:"sendToIndividuals"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsFreestyleJobResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_freestyle_job" // e.g., jenkins_freestyle_job
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsFreestyleJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jenkins freestyle job.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The full name of the job, including its folder.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the freestyle job.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The full name of the folder the job is created in (e.g., `team/maintenance`). Defaults to the Jenkins root.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the job.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the job is disabled, preventing new builds. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"concurrent_build": schema.BoolAttribute{
+				MarkdownDescription: "Whether builds of the job may run concurrently. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"assigned_node": schema.StringAttribute{
+				MarkdownDescription: "A label expression restricting the nodes builds may run on (e.g., `linux && docker`).",
+				Optional:            true,
+			},
+			"scm": schema.SingleNestedAttribute{
+				MarkdownDescription: "The Git repository checked out by builds.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the repository.",
+						Required:            true,
+					},
+					"credentials_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the credentials used to clone the repository.",
+						Optional:            true,
+					},
+					"branch": schema.StringAttribute{
+						MarkdownDescription: "The branch specifier to build. Defaults to `*/master`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("*/master"),
+					},
+				},
+			},
+			"scm_xml": schema.StringAttribute{
+				MarkdownDescription: "Raw XML of the `<scm>` element, for source code management not supported by `scm` (e.g., other SCM plugins or several Git remotes). Conflicts with `scm`.",
+				Optional:            true,
+			},
+			"cron": schema.StringAttribute{
+				MarkdownDescription: "A cron schedule to build the job periodically (e.g., `H 2 * * *`).",
+				Optional:            true,
+			},
+			"poll_scm": schema.StringAttribute{
+				MarkdownDescription: "A cron schedule to poll the repository for changes.",
+				Optional:            true,
+			},
+			"extra_triggers_xml": schema.StringAttribute{
+				MarkdownDescription: "Raw XML of additional triggers provided by plugins, besides `cron` and `poll_scm`.",
+				Optional:            true,
+			},
+			"parameters": schema.ListNestedAttribute{
+				MarkdownDescription: "The parameters of the job.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the parameter.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The kind of parameter: `string`, `text`, `boolean` or `choice`.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "A description of the parameter.",
+							Optional:            true,
+						},
+						"default_value": schema.StringAttribute{
+							MarkdownDescription: "The default value of the parameter; `true` or `false` for `boolean` parameters. Not supported by `choice` parameters, whose first choice is the default.",
+							Optional:            true,
+						},
+						"choices": schema.ListAttribute{
+							MarkdownDescription: "The choices of a `choice` parameter.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"extra_parameters_xml": schema.StringAttribute{
+				MarkdownDescription: "Raw XML of additional parameter definitions not supported by `parameters` (e.g., password or file parameters), listed after `parameters`.",
+				Optional:            true,
+			},
+			"build_steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The build steps, run in order.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The kind of build step: `shell` or `batch` (Windows batch command).",
+							Required:            true,
+						},
+						"command": schema.StringAttribute{
+							MarkdownDescription: "The script to run.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"extra_builders_xml": schema.StringAttribute{
+				MarkdownDescription: "Raw XML of additional build steps provided by plugins, run after `build_steps`.",
+				Optional:            true,
+			},
+			"archive_artifacts": schema.SingleNestedAttribute{
+				MarkdownDescription: "Archives files produced by the build.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"artifacts": schema.StringAttribute{
+						MarkdownDescription: "Comma-separated Ant-style patterns of the files to archive (e.g., `dist/**/*.zip`).",
+						Required:            true,
+					},
+					"allow_empty": schema.BoolAttribute{
+						MarkdownDescription: "Whether the build succeeds when no file matches. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"only_if_successful": schema.BoolAttribute{
+						MarkdownDescription: "Whether artifacts are only archived for successful builds. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"fingerprint": schema.BoolAttribute{
+						MarkdownDescription: "Whether archived artifacts are fingerprinted. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"junit": schema.SingleNestedAttribute{
+				MarkdownDescription: "Publishes JUnit test results (JUnit plugin).",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"test_results": schema.StringAttribute{
+						MarkdownDescription: "Ant-style pattern of the test report files (e.g., `build/test-results/**/*.xml`).",
+						Required:            true,
+					},
+					"allow_empty_results": schema.BoolAttribute{
+						MarkdownDescription: "Whether the build succeeds when no report is found. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"email": schema.SingleNestedAttribute{
+				MarkdownDescription: "Sends e-mail notifications for failed and unstable builds (Mailer plugin).",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"recipients": schema.StringAttribute{
+						MarkdownDescription: "Whitespace-separated e-mail addresses to notify.",
+						Required:            true,
+					},
+					"notify_every_unstable_build": schema.BoolAttribute{
+						MarkdownDescription: "Whether every unstable build triggers a notification, not only the first one. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"send_to_individuals": schema.BoolAttribute{
+						MarkdownDescription: "Whether the authors of the changes that broke the build are notified as well. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"extra_publishers_xml": schema.StringAttribute{
+				MarkdownDescription: "Raw XML of additional post-build publishers provided by plugins.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the build steps and parameters against their type.
+func (r *jenkinsFreestyleJobResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsFreestyleJobResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, step := range config.BuildSteps {
+		if step.Type.IsUnknown() {
+			continue
+		}
+		if _, ok := buildStepClasses[step.Type.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("build_steps").AtListIndex(i).AtName("type"),
+				"Invalid Build Step Type",
+				fmt.Sprintf("Type must be either 'shell' or 'batch', got: '%s'.", step.Type.ValueString()),
+			)
+		}
+	}
+
+	for i, parameter := range config.Parameters {
+		parameterPath := path.Root("parameters").AtListIndex(i)
+		if parameter.Type.IsUnknown() {
+			continue
+		}
+
+		parameterType := parameter.Type.ValueString()
+		if _, ok := parameterClasses[parameterType]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				parameterPath.AtName("type"),
+				"Invalid Parameter Type",
+				fmt.Sprintf("Type must be one of 'string', 'text', 'boolean' or 'choice', got: '%s'.", parameterType),
+			)
+			continue
+		}
+
+		switch {
+		case parameterType == "choice" && len(parameter.Choices) == 0:
+			resp.Diagnostics.AddAttributeError(parameterPath.AtName("choices"), "Missing Choices", "'choices' is required for 'choice' parameters.")
+		case parameterType == "choice" && !parameter.DefaultValue.IsNull():
+			resp.Diagnostics.AddAttributeError(parameterPath.AtName("default_value"), "Unsupported Default Value", "'choice' parameters default to their first choice.")
+		case parameterType != "choice" && parameter.Choices != nil:
+			resp.Diagnostics.AddAttributeError(parameterPath.AtName("choices"), "Unsupported Choices", "'choices' is only supported by 'choice' parameters.")
+		case parameterType == "boolean" && !parameter.DefaultValue.IsNull() && !parameter.DefaultValue.IsUnknown() &&
+			parameter.DefaultValue.ValueString() != "true" && parameter.DefaultValue.ValueString() != "false":
+			resp.Diagnostics.AddAttributeError(parameterPath.AtName("default_value"), "Invalid Default Value", "The default value of 'boolean' parameters must be 'true' or 'false'.")
+		}
+	}
+
+	if config.SCM != nil && !config.SCMXML.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("scm_xml"), "Conflicting SCM Configuration", "'scm_xml' cannot be combined with 'scm'.")
+	}
+	if !config.SCMXML.IsNull() && !config.SCMXML.IsUnknown() {
+		root := &xmlElement{}
+		if err := unmarshalJenkinsXML(config.SCMXML.ValueString(), root); err != nil || root.XMLName.Local != "scm" {
+			resp.Diagnostics.AddAttributeError(path.Root("scm_xml"), "Invalid SCM XML", "'scm_xml' must be a single well-formed <scm> element.")
+		}
+	}
+
+	for name, value := range map[string]types.String{
+		"extra_triggers_xml":   config.ExtraTriggersXML,
+		"extra_parameters_xml": config.ExtraParametersXML,
+		"extra_builders_xml":   config.ExtraBuildersXML,
+		"extra_publishers_xml": config.ExtraPublishersXML,
+	} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if _, err := normalizeXML("<fragment>" + value.ValueString() + "</fragment>"); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid XML", fmt.Sprintf("The value is not well-formed XML: %s", err.Error()))
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsFreestyleJobResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// freestyleRequiredPlugins returns the plugins required by the typed attributes of the planned job.
+func freestyleRequiredPlugins(model *jenkinsFreestyleJobResourceModel) []string {
+	var required []string
+	if model.SCM != nil {
+		required = append(required, "git")
+	}
+	if model.JUnit != nil {
+		required = append(required, "junit")
+	}
+	if model.Email != nil {
+		required = append(required, "mailer")
+	}
+	return required
+}
+
+// buildFreestyleConfigXML generates the config.xml of a freestyle job from the resource model.
+func buildFreestyleConfigXML(model *jenkinsFreestyleJobResourceModel, pluginVersions map[string]string) string {
+	var b strings.Builder
+
+	b.WriteString("<?xml version='1.1' encoding='UTF-8'?>\n<project>\n  <actions/>\n")
+	fmt.Fprintf(&b, "  <description>%s</description>\n", escapeXML(model.Description.ValueString()))
+	b.WriteString("  <keepDependencies>false</keepDependencies>\n")
+
+	// Parameters
+	if len(model.Parameters) == 0 && model.ExtraParametersXML.IsNull() {
+		b.WriteString("  <properties/>\n")
+	} else {
+		b.WriteString("  <properties>\n    <hudson.model.ParametersDefinitionProperty>\n      <parameterDefinitions>\n")
+		for _, parameter := range model.Parameters {
+			class := parameterClasses[parameter.Type.ValueString()]
+			fmt.Fprintf(&b, "        <%s>\n", class)
+			fmt.Fprintf(&b, "          <name>%s</name>\n", escapeXML(parameter.Name.ValueString()))
+			fmt.Fprintf(&b, "          <description>%s</description>\n", escapeXML(parameter.Description.ValueString()))
+			switch parameter.Type.ValueString() {
+			case "choice":
+				b.WriteString("          <choices class=\"java.util.Arrays$ArrayList\">\n            <a class=\"string-array\">\n")
+				for _, choice := range parameter.Choices {
+					fmt.Fprintf(&b, "              <string>%s</string>\n", escapeXML(choice))
+				}
+				b.WriteString("            </a>\n          </choices>\n")
+			case "boolean":
+				fmt.Fprintf(&b, "          <defaultValue>%t</defaultValue>\n", parameter.DefaultValue.ValueString() == "true")
+			default:
+				fmt.Fprintf(&b, "          <defaultValue>%s</defaultValue>\n          <trim>false</trim>\n", escapeXML(parameter.DefaultValue.ValueString()))
+			}
+			fmt.Fprintf(&b, "        </%s>\n", class)
+		}
+		if !model.ExtraParametersXML.IsNull() {
+			b.WriteString(model.ExtraParametersXML.ValueString() + "\n")
+		}
+		b.WriteString("      </parameterDefinitions>\n    </hudson.model.ParametersDefinitionProperty>\n  </properties>\n")
+	}
+
+	// Source code management
+	if !model.SCMXML.IsNull() {
+		b.WriteString("  " + model.SCMXML.ValueString() + "\n")
+	} else if model.SCM == nil {
+		b.WriteString("  <scm class=\"hudson.scm.NullSCM\"/>\n")
+	} else {
+		fmt.Fprintf(&b, `  <scm class="hudson.plugins.git.GitSCM" plugin="%s">
+    <configVersion>2</configVersion>
+    <userRemoteConfigs>
+      <hudson.plugins.git.UserRemoteConfig>
+        <url>%s</url>
+        <credentialsId>%s</credentialsId>
+      </hudson.plugins.git.UserRemoteConfig>
+    </userRemoteConfigs>
+    <branches>
+      <hudson.plugins.git.BranchSpec>
+        <name>%s</name>
+      </hudson.plugins.git.BranchSpec>
+    </branches>
+    <doGenerateSubmoduleConfigurations>false</doGenerateSubmoduleConfigurations>
+    <submoduleCfg class="empty-list"/>
+    <extensions/>
+  </scm>
+`, escapeXML(pluginAttribute(pluginVersions, "git")), escapeXML(model.SCM.URL.ValueString()), escapeXML(model.SCM.CredentialsID.ValueString()), escapeXML(model.SCM.Branch.ValueString()))
+	}
+
+	// Node restriction
+	if !model.AssignedNode.IsNull() && model.AssignedNode.ValueString() != "" {
+		fmt.Fprintf(&b, "  <assignedNode>%s</assignedNode>\n  <canRoam>false</canRoam>\n", escapeXML(model.AssignedNode.ValueString()))
+	} else {
+		b.WriteString("  <canRoam>true</canRoam>\n")
+	}
+	fmt.Fprintf(&b, "  <disabled>%t</disabled>\n", model.Disabled.ValueBool())
+	b.WriteString("  <blockBuildWhenDownstreamBuilding>false</blockBuildWhenDownstreamBuilding>\n")
+	b.WriteString("  <blockBuildWhenUpstreamBuilding>false</blockBuildWhenUpstreamBuilding>\n")
+
+	// Triggers
+	b.WriteString("  <triggers>\n")
+	if !model.Cron.IsNull() {
+		fmt.Fprintf(&b, "    <hudson.triggers.TimerTrigger>\n      <spec>%s</spec>\n    </hudson.triggers.TimerTrigger>\n", escapeXML(model.Cron.ValueString()))
+	}
+	if !model.PollSCM.IsNull() {
+		fmt.Fprintf(&b, "    <hudson.triggers.SCMTrigger>\n      <spec>%s</spec>\n      <ignorePostCommitHooks>false</ignorePostCommitHooks>\n    </hudson.triggers.SCMTrigger>\n", escapeXML(model.PollSCM.ValueString()))
+	}
+	if !model.ExtraTriggersXML.IsNull() {
+		b.WriteString(model.ExtraTriggersXML.ValueString() + "\n")
+	}
+	b.WriteString("  </triggers>\n")
+	fmt.Fprintf(&b, "  <concurrentBuild>%t</concurrentBuild>\n", model.ConcurrentBuild.ValueBool())
+
+	// Build steps
+	b.WriteString("  <builders>\n")
+	for _, step := range model.BuildSteps {
+		class := buildStepClasses[step.Type.ValueString()]
+		fmt.Fprintf(&b, "    <%s>\n      <command>%s</command>\n    </%s>\n", class, escapeXML(step.Command.ValueString()), class)
+	}
+	if !model.ExtraBuildersXML.IsNull() {
+		b.WriteString(model.ExtraBuildersXML.ValueString() + "\n")
+	}
+	b.WriteString("  </builders>\n")
+
+	// Publishers
+	b.WriteString("  <publishers>\n")
+	if a := model.ArchiveArtifacts; a != nil {
+		fmt.Fprintf(&b, `    <%[1]s>
+      <artifacts>%[2]s</artifacts>
+      <allowEmptyArchive>%[3]t</allowEmptyArchive>
+      <onlyIfSuccessful>%[4]t</onlyIfSuccessful>
+      <fingerprint>%[5]t</fingerprint>
+      <defaultExcludes>true</defaultExcludes>
+      <caseSensitive>true</caseSensitive>
+      <followSymlinks>true</followSymlinks>
+    </%[1]s>
+`, artifactArchiverClass, escapeXML(a.Artifacts.ValueString()), a.AllowEmpty.ValueBool(), a.OnlyIfSuccessful.ValueBool(), a.Fingerprint.ValueBool())
+	}
+	if j := model.JUnit; j != nil {
+		fmt.Fprintf(&b, `    <%[1]s plugin="%[4]s">
+      <testResults>%[2]s</testResults>
+      <keepLongStdio>false</keepLongStdio>
+      <healthScaleFactor>1.0</healthScaleFactor>
+      <allowEmptyResults>%[3]t</allowEmptyResults>
+    </%[1]s>
+`, junitArchiverClass, escapeXML(j.TestResults.ValueString()), j.AllowEmptyResults.ValueBool(), escapeXML(pluginAttribute(pluginVersions, "junit")))
+	}
+	if e := model.Email; e != nil {
+		fmt.Fprintf(&b, `    <%[1]s plugin="%[5]s">
+      <recipients>%[2]s</recipients>
+      <dontNotifyEveryUnstableBuild>%[3]t</dontNotifyEveryUnstableBuild>
+      <sendToIndividuals>%[4]t</sendToIndividuals>
+    </%[1]s>
+`, mailerClass, escapeXML(e.Recipients.ValueString()), !e.NotifyEveryUnstableBuild.ValueBool(), e.SendToIndividuals.ValueBool(),
+			escapeXML(pluginAttribute(pluginVersions, "mailer")))
+	}
+	if !model.ExtraPublishersXML.IsNull() {
+		b.WriteString(model.ExtraPublishersXML.ValueString() + "\n")
+	}
+	b.WriteString("  </publishers>\n  <buildWrappers/>\n</project>")
+
+	return b.String()
+}
+
+// readOptionalString converts a value read from Jenkins, keeping the attribute unset when
+// it was not configured and Jenkins reports the implicit value.
+func readOptionalString(prior types.String, value, implicit string) types.String {
+	if prior.IsNull() && value == implicit {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// readExtraXML serializes the elements not managed by typed attributes, keeping the
+// configured value when Jenkins holds the same elements, ignoring formatting, `plugin`
+// attributes and default settings Jenkins adds.
+func readExtraXML(prior types.String, elements []rawXMLElement) (types.String, error) {
+	var b strings.Builder
+	for _, element := range elements {
+		raw, err := xml.Marshal(element)
+		if err != nil {
+			return types.StringNull(), err
+		}
+		b.Write(raw)
+	}
+	if b.Len() == 0 {
+		return types.StringNull(), nil
+	}
+
+	if !prior.IsNull() {
+		current, err := parseXMLNode("<fragment>" + b.String() + "</fragment>")
+		if err != nil {
+			return types.StringNull(), err
+		}
+		configured, err := parseXMLNode("<fragment>" + prior.ValueString() + "</fragment>")
+		if err == nil && len(configured.Children) == len(current.Children) && xmlNodeContains(configured, current) {
+			return prior, nil
+		}
+	}
+	return types.StringValue(b.String()), nil
+}
+
+// scmClass returns the class of an <scm> element.
+func scmClass(element *rawXMLElement) string {
+	for _, attr := range element.Attrs {
+		if attr.Name.Local == "class" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// decodeRawXMLElement decodes the content of a raw element into v.
+func decodeRawXMLElement(element rawXMLElement, v interface{}) error {
+	return xml.Unmarshal([]byte("<element>"+element.Inner+"</element>"), v)
+}
+
+// applyFreestyleConfig copies the freestyle job configuration read from Jenkins into the resource model.
+func applyFreestyleConfig(configXML string, model *jenkinsFreestyleJobResourceModel) error {
+	config := &freestyleConfig{}
+	if err := unmarshalJenkinsXML(configXML, config); err != nil {
+		return fmt.Errorf("could not parse job config.xml: %s", err.Error())
+	}
+	if config.XMLName.Local != "project" {
+		return fmt.Errorf("job is a %s, not a freestyle job", config.XMLName.Local)
+	}
+
+	model.Description = types.StringValue(config.Description)
+	model.Disabled = types.BoolValue(config.Disabled)
+	model.ConcurrentBuild = types.BoolValue(config.Concurrent)
+	model.AssignedNode = optionalString(config.AssignedNode)
+
+	// Source code management: anything `scm` cannot represent is kept in scm_xml
+	priorSCMXML := model.SCMXML
+	model.SCM = nil
+	model.SCMXML = types.StringNull()
+	if config.SCM != nil && scmClass(config.SCM) != "hudson.scm.NullSCM" {
+		git := gitSCMConfig{}
+		if scmClass(config.SCM) == "hudson.plugins.git.GitSCM" {
+			if err := decodeRawXMLElement(*config.SCM, &git); err != nil {
+				return fmt.Errorf("could not parse scm: %s", err.Error())
+			}
+		}
+		if priorSCMXML.IsNull() && len(git.Remotes) == 1 && len(git.Branches) <= 1 {
+			branch := "**"
+			if len(git.Branches) > 0 {
+				branch = git.Branches[0]
+			}
+			model.SCM = &freestyleSCMModel{
+				URL:           types.StringValue(git.Remotes[0].URL),
+				CredentialsID: optionalString(git.Remotes[0].CredentialsID),
+				Branch:        types.StringValue(branch),
+			}
+		} else {
+			scmXML, err := readExtraXML(priorSCMXML, []rawXMLElement{*config.SCM})
+			if err != nil {
+				return fmt.Errorf("could not serialize scm: %s", err.Error())
+			}
+			model.SCMXML = scmXML
+		}
+	}
+
+	// Triggers
+	model.Cron = types.StringNull()
+	model.PollSCM = types.StringNull()
+	var extraTriggers []rawXMLElement
+	for _, element := range config.Triggers.Items {
+		var trigger struct {
+			Spec string `xml:"spec"`
+		}
+		switch element.XMLName.Local {
+		case timerTriggerClass, scmTriggerClass:
+			if err := decodeRawXMLElement(element, &trigger); err != nil {
+				return fmt.Errorf("could not parse trigger: %s", err.Error())
+			}
+		}
+
+		switch element.XMLName.Local {
+		case timerTriggerClass:
+			model.Cron = types.StringValue(trigger.Spec)
+		case scmTriggerClass:
+			model.PollSCM = types.StringValue(trigger.Spec)
+		default:
+			extraTriggers = append(extraTriggers, element)
+		}
+	}
+	extraTriggersXML, err := readExtraXML(model.ExtraTriggersXML, extraTriggers)
+	if err != nil {
+		return fmt.Errorf("could not serialize triggers: %s", err.Error())
+	}
+	model.ExtraTriggersXML = extraTriggersXML
+
+	// Parameters
+	var parameters []freestyleParameterModel
+	var extraParameters []rawXMLElement
+	for _, element := range config.Parameters.Items {
+		parameterType := ""
+		for name, class := range parameterClasses {
+			if class == element.XMLName.Local {
+				parameterType = name
+			}
+		}
+		if parameterType == "" {
+			extraParameters = append(extraParameters, element)
+			continue
+		}
+		i := len(parameters)
+
+		definition := parameterConfig{}
+		if err := decodeRawXMLElement(element, &definition); err != nil {
+			return fmt.Errorf("could not parse parameter definition: %s", err.Error())
+		}
+
+		prior := freestyleParameterModel{Description: types.StringNull(), DefaultValue: types.StringNull()}
+		if i < len(model.Parameters) {
+			prior = model.Parameters[i]
+		}
+
+		parameter := freestyleParameterModel{
+			Name:         types.StringValue(definition.Name),
+			Type:         types.StringValue(parameterType),
+			Description:  readOptionalString(prior.Description, definition.Description, ""),
+			DefaultValue: types.StringNull(),
+		}
+		switch parameterType {
+		case "choice":
+			parameter.Choices = append(definition.ChoiceArray, definition.ChoiceList...)
+		case "boolean":
+			parameter.DefaultValue = readOptionalString(prior.DefaultValue, definition.DefaultValue, "false")
+		default:
+			parameter.DefaultValue = readOptionalString(prior.DefaultValue, definition.DefaultValue, "")
+		}
+		parameters = append(parameters, parameter)
+	}
+	if parameters == nil && model.Parameters != nil {
+		parameters = []freestyleParameterModel{}
+	}
+	model.Parameters = parameters
+
+	extraParametersXML, err := readExtraXML(model.ExtraParametersXML, extraParameters)
+	if err != nil {
+		return fmt.Errorf("could not serialize parameter definitions: %s", err.Error())
+	}
+	model.ExtraParametersXML = extraParametersXML
+
+	// Build steps
+	var steps []freestyleBuildStepModel
+	var extraBuilders []rawXMLElement
+	for _, element := range config.Builders.Items {
+		stepType := ""
+		for name, class := range buildStepClasses {
+			if class == element.XMLName.Local {
+				stepType = name
+			}
+		}
+		if stepType == "" {
+			extraBuilders = append(extraBuilders, element)
+			continue
+		}
+
+		var step struct {
+			Command string `xml:"command"`
+		}
+		if err := decodeRawXMLElement(element, &step); err != nil {
+			return fmt.Errorf("could not parse build step: %s", err.Error())
+		}
+		steps = append(steps, freestyleBuildStepModel{
+			Type:    types.StringValue(stepType),
+			Command: types.StringValue(step.Command),
+		})
+	}
+	if steps == nil && model.BuildSteps != nil {
+		steps = []freestyleBuildStepModel{}
+	}
+	model.BuildSteps = steps
+
+	extraBuildersXML, err := readExtraXML(model.ExtraBuildersXML, extraBuilders)
+	if err != nil {
+		return fmt.Errorf("could not serialize build steps: %s", err.Error())
+	}
+	model.ExtraBuildersXML = extraBuildersXML
+
+	// Publishers
+	model.ArchiveArtifacts, model.JUnit, model.Email = nil, nil, nil
+	var extraPublishers []rawXMLElement
+	for _, element := range config.Publishers.Items {
+		publisher := publisherConfig{}
+		switch element.XMLName.Local {
+		case artifactArchiverClass, junitArchiverClass, mailerClass:
+			if err := decodeRawXMLElement(element, &publisher); err != nil {
+				return fmt.Errorf("could not parse publisher: %s", err.Error())
+			}
+		}
+
+		switch element.XMLName.Local {
+		case artifactArchiverClass:
+			model.ArchiveArtifacts = &archiveArtifactsModel{
+				Artifacts:        types.StringValue(publisher.Artifacts),
+				AllowEmpty:       types.BoolValue(publisher.AllowEmptyArchive),
+				OnlyIfSuccessful: types.BoolValue(publisher.OnlyIfSuccessful),
+				Fingerprint:      types.BoolValue(publisher.Fingerprint),
+			}
+		case junitArchiverClass:
+			model.JUnit = &junitPublisherModel{
+				TestResults:       types.StringValue(publisher.TestResults),
+				AllowEmptyResults: types.BoolValue(publisher.AllowEmptyResults),
+			}
+		case mailerClass:
+			model.Email = &emailNotificationModel{
+				Recipients:               types.StringValue(publisher.Recipients),
+				NotifyEveryUnstableBuild: types.BoolValue(!publisher.DontNotifyEveryUnstableBuild),
+				SendToIndividuals:        types.BoolValue(publisher.SendToIndividuals),
+			}
+		default:
+			extraPublishers = append(extraPublishers, element)
+		}
+	}
+
+	extraPublishersXML, err := readExtraXML(model.ExtraPublishersXML, extraPublishers)
+	if err != nil {
+		return fmt.Errorf("could not serialize publishers: %s", err.Error())
+	}
+	model.ExtraPublishersXML = extraPublishersXML
+
+	return nil
+}
+
+// Create a new freestyle job.
+func (r *jenkinsFreestyleJobResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsFreestyleJobResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := plan.Name.ValueString()
+	parents := folderParents(plan.Folder.ValueString())
+	fullName := strings.Join(append(parents, jobName), "/")
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, freestyleRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if job already exists (idempotency)
+	_, err := r.client.GetJob(ctx, jobName, parents...)
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Job Already Exists",
+			fmt.Sprintf("Jenkins job '%s' already exists. Consider importing it or using a different name.", fullName),
+		)
+		return
+	} else if !strings.Contains(err.Error(), "404") {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to check if job '%s' exists: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	job, err := r.client.CreateJobInFolder(ctx, buildFreestyleConfigXML(&plan, pluginVersions), jobName, parents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Creation Error",
+			fmt.Sprintf("Failed to create Jenkins freestyle job '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Read back the created job to ensure consistency
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyFreestyleConfig(configXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error After Create",
+			fmt.Sprintf("Failed to read created Jenkins freestyle job '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fullName)
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins freestyle job '%s' created successfully.", fullName)
+}
+
+// Read retrieves the current state of a freestyle job.
+func (r *jenkinsFreestyleJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsFreestyleJobResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			// Job no longer exists in Jenkins, remove from Terraform state
+			resp.State.RemoveResource(ctx)
+			log.Printf("[INFO] Jenkins freestyle job '%s' not found, removing from state.", fullName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error",
+			fmt.Sprintf("Failed to get Jenkins job details for '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyFreestyleConfig(configXML, &state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Config Read Error",
+			fmt.Sprintf("Failed to read Jenkins freestyle job config for '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(jobName)
+	if folder != "" {
+		state.Folder = types.StringValue(folder)
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins freestyle job '%s' read successfully.", fullName)
+}
+
+// Update an existing freestyle job.
+func (r *jenkinsFreestyleJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsFreestyleJobResourceModel
+	var state jenkinsFreestyleJobResourceModel
+
+	// Get the plan (desired state) and current state from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.Diagnostics.AddError(
+				"Jenkins Job Not Found For Update",
+				fmt.Sprintf("Cannot update job '%s' because it does not exist in Jenkins.", fullName),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error During Update Check",
+			fmt.Sprintf("Failed to check if job '%s' exists before update: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, freestyleRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := job.UpdateConfig(ctx, buildFreestyleConfigXML(&plan, pluginVersions)); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Update Error",
+			fmt.Sprintf("Failed to update Jenkins freestyle job '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Re-fetch the job after update
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyFreestyleConfig(configXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error After Update",
+			fmt.Sprintf("Failed to re-read updated Jenkins freestyle job '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins freestyle job '%s' updated successfully.", fullName)
+}
+
+// Delete a freestyle job.
+func (r *jenkinsFreestyleJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsFreestyleJobResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	// Check if job exists before attempting to delete (idempotency)
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[INFO] Jenkins freestyle job '%s' not found (already deleted).", fullName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error Before Deletion",
+			fmt.Sprintf("Failed to check if job '%s' exists before deletion: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	if _, err := job.Delete(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Deletion Error",
+			fmt.Sprintf("Failed to delete Jenkins freestyle job '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	log.Printf("[INFO] Jenkins freestyle job '%s' deleted successfully.", fullName)
+}
+
+// ImportState allows importing existing freestyle jobs into Terraform state.
+func (r *jenkinsFreestyleJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The imported ID is the full name of the job, e.g. `maintenance/nightly-cleanup`.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,938 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsMultibranchPipelineResource{}
+var _ resource.ResourceWithImportState = &jenkinsMultibranchPipelineResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsMultibranchPipelineResource{}
+
+// scmKind describes how a hosting provider's SCM source and discovery traits are serialized.
+// Trait element names are class names with underscores doubled by XStream.
+type scmKind struct {
+	SourceClass    string // Class of the branch source
+	Plugin         string // Plugin providing the source
+	TraitPrefix    string // Package of the discovery traits, as an XML element prefix
+	ForkTrust      string // Trust policy used for fork pull requests
+	Request        string // Name of change requests in trait classes, `PullRequest` unless set
+	BranchStrategy bool   // Whether branch discovery takes a strategyId
+}
+
+// changeRequest returns how the hosting provider names pull requests in its trait classes.
+func (k scmKind) changeRequest() string {
+	if k.Request == "" {
+		return "PullRequest"
+	}
+	return k.Request
+}
+
+// scmKinds maps the values of the branch source `type` attribute to their serialization.
+var scmKinds = map[string]scmKind{
+	"git": {
+		SourceClass: "jenkins.plugins.git.GitSCMSource",
+		Plugin:      "git",
+		TraitPrefix: "jenkins.plugins.git.traits.",
+	},
+	"github": {
+		SourceClass:    "org.jenkinsci.plugins.github_branch_source.GitHubSCMSource",
+		Plugin:         "github-branch-source",
+		TraitPrefix:    "org.jenkinsci.plugins.github__branch__source.",
+		ForkTrust:      "org.jenkinsci.plugins.github_branch_source.ForkPullRequestDiscoveryTrait$TrustPermission",
+		BranchStrategy: true,
+	},
+	"bitbucket": {
+		SourceClass:    "com.cloudbees.jenkins.plugins.bitbucket.BitbucketSCMSource",
+		Plugin:         "cloudbees-bitbucket-branch-source",
+		TraitPrefix:    "com.cloudbees.jenkins.plugins.bitbucket.",
+		ForkTrust:      "com.cloudbees.jenkins.plugins.bitbucket.ForkPullRequestDiscoveryTrait$TrustTeamForks",
+		BranchStrategy: true,
+	},
+}
+
+// branchDiscoveryStrategies maps `discover_branches` values to BranchDiscoveryTrait strategy IDs.
+var branchDiscoveryStrategies = map[string]int64{
+	"exclude_prs": 1,
+	"only_prs":    2,
+	"all":         3,
+}
+
+// pullRequestDiscoveryStrategies maps pull request discovery values to trait strategy IDs.
+var pullRequestDiscoveryStrategies = map[string]int64{
+	"merge": 1,
+	"head":  2,
+	"both":  3,
+}
+
+// NewJenkinsMultibranchPipelineResource is a helper function to simplify provider development.
+func NewJenkinsMultibranchPipelineResource() resource.Resource {
+	return &jenkinsMultibranchPipelineResource{}
+}
+
+// jenkinsMultibranchPipelineResource defines the resource implementation.
+type jenkinsMultibranchPipelineResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsMultibranchPipelineResourceModel describes the resource data model for a multibranch pipeline.
+type jenkinsMultibranchPipelineResourceModel struct {
+	ID                   types.String               `tfsdk:"id"`                     // Unique identifier (full job name)
+	Name                 types.String               `tfsdk:"name"`                   // Name of the job
+	Folder               types.String               `tfsdk:"folder"`                 // Full name of the folder containing the job
+	Description          types.String               `tfsdk:"description"`            // Description of the job
+	ScriptPath           types.String               `tfsdk:"script_path"`            // Path of the Jenkinsfile in each branch
+	ScanIntervalMinutes  types.Int64                `tfsdk:"scan_interval_minutes"`  // Periodic branch indexing interval
+	BranchSources        []multibranchSourceModel   `tfsdk:"branch_sources"`         // Branch sources to index
+	OrphanedItemStrategy *orphanedItemStrategyModel `tfsdk:"orphaned_item_strategy"` // Handling of branches that disappeared
+	IndexOnCreate        types.Bool                 `tfsdk:"index_on_create"`        // Whether to start branch indexing after creation
+}
+
+// multibranchSourceModel describes a single branch source of a multibranch pipeline.
+type multibranchSourceModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Type                     types.String `tfsdk:"type"`
+	Remote                   types.String `tfsdk:"remote"`
+	Owner                    types.String `tfsdk:"owner"`
+	Repository               types.String `tfsdk:"repository"`
+	ServerURL                types.String `tfsdk:"server_url"`
+	CredentialsID            types.String `tfsdk:"credentials_id"`
+	DiscoverBranches         types.String `tfsdk:"discover_branches"`
+	DiscoverPullRequests     types.String `tfsdk:"discover_pull_requests"`
+	DiscoverForkPullRequests types.String `tfsdk:"discover_fork_pull_requests"`
+	DiscoverTags             types.Bool   `tfsdk:"discover_tags"`
+}
+
+// orphanedItemStrategyModel describes what happens to items whose branch no longer exists.
+type orphanedItemStrategyModel struct {
+	PruneDeadBranches types.Bool  `tfsdk:"prune_dead_branches"`
+	DaysToKeep        types.Int64 `tfsdk:"days_to_keep"`
+	NumToKeep         types.Int64 `tfsdk:"num_to_keep"`
+	AbortBuilds       types.Bool  `tfsdk:"abort_builds"`
+}
+
+// scmTraitConfig captures a trait element and the settings of the traits managed by this provider.
+type scmTraitConfig struct {
+	XMLName    xml.Name
+	StrategyID int64  `xml:"strategyId"`
+	Regex      string `xml:"regex"`
+}
+
+// orphanedItemStrategyConfig mirrors DefaultOrphanedItemStrategy in config.xml.
+type orphanedItemStrategyConfig struct {
+	PruneDeadBranches bool  `xml:"pruneDeadBranches"`
+	DaysToKeep        int64 `xml:"daysToKeep"`
+	NumToKeep         int64 `xml:"numToKeep"`
+	AbortBuilds       bool  `xml:"abortBuilds"`
+}
+
+// branchSourceConfig mirrors an SCM source in config.xml.
+type branchSourceConfig struct {
+	Class         string `xml:"class,attr"`
+	ID            string `xml:"id"`
+	Remote        string `xml:"remote"`
+	CredentialsID string `xml:"credentialsId"`
+	RepoOwner     string `xml:"repoOwner"`
+	Repository    string `xml:"repository"`
+	APIURI        string `xml:"apiUri"`
+	ServerURL     string `xml:"serverUrl"`
+	Traits        struct {
+		Items []scmTraitConfig `xml:",any"`
+	} `xml:"traits"`
+}
+
+// multibranchConfig mirrors the parts of a WorkflowMultiBranchProject config.xml managed by this provider.
+type multibranchConfig struct {
+	XMLName     xml.Name
+	Description string                      `xml:"description"`
+	Orphaned    *orphanedItemStrategyConfig `xml:"orphanedItemStrategy"`
+	Periodic    *struct {
+		Interval int64 `xml:"interval"`
+	} `xml:"triggers>com.cloudbees.hudson.plugins.folder.computed.PeriodicFolderTrigger"`
+	Sources []struct {
+		Source branchSourceConfig `xml:"source"`
+	} `xml:"sources>data>jenkins.branch.BranchSource"`
+	ScriptPath string `xml:"factory>scriptPath"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsMultibranchPipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_multibranch_pipeline" // e.g., jenkins_multibranch_pipeline
+}
+
+// orphanedItemStrategySchema returns the schema of the `orphaned_item_strategy` attribute.
+func orphanedItemStrategySchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "What to do with items whose branch or repository no longer exists. Defaults to removing them immediately.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"prune_dead_branches": schema.BoolAttribute{
+				MarkdownDescription: "Whether orphaned items are removed at all. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"days_to_keep": schema.Int64Attribute{
+				MarkdownDescription: "Days to keep orphaned items, `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"num_to_keep": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of orphaned items to keep, `-1` for no limit. Defaults to `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(-1),
+			},
+			"abort_builds": schema.BoolAttribute{
+				MarkdownDescription: "Whether running builds of orphaned items are aborted. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsMultibranchPipelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jenkins multibranch pipeline job.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The full name of the job, including its folder.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the multibranch pipeline job.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The full name of the folder the job is created in (e.g., `team/services`). Defaults to the Jenkins root.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the job.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"script_path": schema.StringAttribute{
+				MarkdownDescription: "The path of the pipeline script in each branch. Defaults to `Jenkinsfile`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("Jenkinsfile"),
+			},
+			"scan_interval_minutes": schema.Int64Attribute{
+				MarkdownDescription: "How often branches are re-indexed when no webhook notifies Jenkins, in minutes. `0` disables periodic scanning. Defaults to `1440` (one day).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1440),
+			},
+			"branch_sources": schema.ListNestedAttribute{
+				MarkdownDescription: "The repositories whose branches become pipeline jobs.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "A unique, stable identifier of the source. Defaults to an identifier derived from the repository.",
+							Optional:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The kind of source: `git`, `github` (GitHub Branch Source plugin) or `bitbucket` (Bitbucket Branch Source plugin).",
+							Required:            true,
+						},
+						"remote": schema.StringAttribute{
+							MarkdownDescription: "The repository URL of a `git` source.",
+							Optional:            true,
+						},
+						"owner": schema.StringAttribute{
+							MarkdownDescription: "The organization, user or team owning the repository of a `github` or `bitbucket` source.",
+							Optional:            true,
+						},
+						"repository": schema.StringAttribute{
+							MarkdownDescription: "The repository name of a `github` or `bitbucket` source.",
+							Optional:            true,
+						},
+						"server_url": schema.StringAttribute{
+							MarkdownDescription: "The API endpoint of GitHub Enterprise or the Bitbucket Server URL. Defaults to the public service.",
+							Optional:            true,
+						},
+						"credentials_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the credentials used to scan the repository.",
+							Optional:            true,
+						},
+						"discover_branches": schema.StringAttribute{
+							MarkdownDescription: "Which branches to build: `all`, `exclude_prs` (branches not filed as pull requests), `only_prs` (branches filed as pull requests) or `none`. `git` sources only support `all` and `none`. Defaults to `all`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("all"),
+						},
+						"discover_pull_requests": schema.StringAttribute{
+							MarkdownDescription: "Which revision of pull requests from the origin repository to build: `merge`, `head`, `both` or `none`. Defaults to `none`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("none"),
+						},
+						"discover_fork_pull_requests": schema.StringAttribute{
+							MarkdownDescription: "Which revision of pull requests from forks to build: `merge`, `head`, `both` or `none`. Only forks from trusted contributors may change the pipeline script. Defaults to `none`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("none"),
+						},
+						"discover_tags": schema.BoolAttribute{
+							MarkdownDescription: "Whether tags are discovered. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"orphaned_item_strategy": orphanedItemStrategySchema(),
+			"index_on_create": schema.BoolAttribute{
+				MarkdownDescription: "Whether to start branch indexing right after the job is created. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the branch source settings against their type.
+func (r *jenkinsMultibranchPipelineResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsMultibranchPipelineResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, source := range config.BranchSources {
+		sourcePath := path.Root("branch_sources").AtListIndex(i)
+		if source.Type.IsUnknown() {
+			continue
+		}
+
+		sourceType := source.Type.ValueString()
+		if _, ok := scmKinds[sourceType]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				sourcePath.AtName("type"),
+				"Invalid Branch Source Type",
+				fmt.Sprintf("Type must be one of 'git', 'github' or 'bitbucket', got: '%s'.", sourceType),
+			)
+			continue
+		}
+
+		if sourceType == "git" {
+			if source.Remote.IsNull() {
+				resp.Diagnostics.AddAttributeError(sourcePath.AtName("remote"), "Missing Repository URL", "'remote' is required for 'git' branch sources.")
+			}
+			if v := source.DiscoverBranches.ValueString(); v != "" && v != "all" && v != "none" && !source.DiscoverBranches.IsUnknown() {
+				resp.Diagnostics.AddAttributeError(sourcePath.AtName("discover_branches"), "Unsupported Branch Discovery", "'git' branch sources only support 'all' or 'none'.")
+			}
+			for name, value := range map[string]types.String{
+				"discover_pull_requests":      source.DiscoverPullRequests,
+				"discover_fork_pull_requests": source.DiscoverForkPullRequests,
+			} {
+				if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "none" {
+					resp.Diagnostics.AddAttributeError(sourcePath.AtName(name), "Unsupported Pull Request Discovery", "'git' branch sources cannot discover pull requests.")
+				}
+			}
+			continue
+		}
+
+		if source.Owner.IsNull() || source.Repository.IsNull() {
+			resp.Diagnostics.AddAttributeError(sourcePath, "Missing Repository", fmt.Sprintf("'owner' and 'repository' are required for '%s' branch sources.", sourceType))
+		}
+		if v := source.DiscoverBranches; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "none" {
+			if _, ok := branchDiscoveryStrategies[v.ValueString()]; !ok {
+				resp.Diagnostics.AddAttributeError(sourcePath.AtName("discover_branches"), "Invalid Branch Discovery", fmt.Sprintf("Unknown branch discovery strategy '%s'.", v.ValueString()))
+			}
+		}
+		for name, value := range map[string]types.String{
+			"discover_pull_requests":      source.DiscoverPullRequests,
+			"discover_fork_pull_requests": source.DiscoverForkPullRequests,
+		} {
+			if value.IsNull() || value.IsUnknown() || value.ValueString() == "none" {
+				continue
+			}
+			if _, ok := pullRequestDiscoveryStrategies[value.ValueString()]; !ok {
+				resp.Diagnostics.AddAttributeError(sourcePath.AtName(name), "Invalid Pull Request Discovery", fmt.Sprintf("Unknown pull request discovery strategy '%s'.", value.ValueString()))
+			}
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsMultibranchPipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// scmTraitsXML renders the discovery traits of an SCM source or navigator.
+func scmTraitsXML(kind scmKind, branches, pullRequests, forkPullRequests string, tags bool) string {
+	var b strings.Builder
+	if branches != "none" {
+		if strategy, ok := branchDiscoveryStrategies[branches]; ok && kind.BranchStrategy {
+			fmt.Fprintf(&b, "\n            <%sBranchDiscoveryTrait>\n              <strategyId>%d</strategyId>\n            </%sBranchDiscoveryTrait>", kind.TraitPrefix, strategy, kind.TraitPrefix)
+		} else {
+			fmt.Fprintf(&b, "\n            <%sBranchDiscoveryTrait/>", kind.TraitPrefix)
+		}
+	}
+	if strategy, ok := pullRequestDiscoveryStrategies[pullRequests]; ok {
+		trait := kind.TraitPrefix + "Origin" + kind.changeRequest() + "DiscoveryTrait"
+		fmt.Fprintf(&b, "\n            <%s>\n              <strategyId>%d</strategyId>\n            </%s>", trait, strategy, trait)
+	}
+	if strategy, ok := pullRequestDiscoveryStrategies[forkPullRequests]; ok {
+		trait := kind.TraitPrefix + "Fork" + kind.changeRequest() + "DiscoveryTrait"
+		fmt.Fprintf(&b, "\n            <%s>\n              <strategyId>%d</strategyId>\n              <trust class=\"%s\"/>\n            </%s>", trait, strategy, kind.ForkTrust, trait)
+	}
+	if tags {
+		fmt.Fprintf(&b, "\n            <%sTagDiscoveryTrait/>", kind.TraitPrefix)
+	}
+	return b.String()
+}
+
+// parseSCMTraits reads the discovery settings back from the traits of an SCM source or navigator.
+func parseSCMTraits(kind scmKind, traits []scmTraitConfig) (branches, pullRequests, forkPullRequests string, tags bool) {
+	branches, pullRequests, forkPullRequests = "none", "none", "none"
+	strategyName := func(strategies map[string]int64, id int64) string {
+		for name, strategy := range strategies {
+			if strategy == id {
+				return name
+			}
+		}
+		return "none"
+	}
+
+	for _, trait := range traits {
+		switch strings.TrimPrefix(trait.XMLName.Local, kind.TraitPrefix) {
+		case "BranchDiscoveryTrait":
+			branches = "all"
+			if kind.BranchStrategy {
+				branches = strategyName(branchDiscoveryStrategies, trait.StrategyID)
+			}
+		case "OriginPullRequestDiscoveryTrait", "OriginMergeRequestDiscoveryTrait":
+			pullRequests = strategyName(pullRequestDiscoveryStrategies, trait.StrategyID)
+		case "ForkPullRequestDiscoveryTrait", "ForkMergeRequestDiscoveryTrait":
+			forkPullRequests = strategyName(pullRequestDiscoveryStrategies, trait.StrategyID)
+		case "TagDiscoveryTrait":
+			tags = true
+		}
+	}
+	return branches, pullRequests, forkPullRequests, tags
+}
+
+// defaultBranchSourceID derives a stable branch source ID from the repository it points to.
+func defaultBranchSourceID(source *multibranchSourceModel) string {
+	if source.Type.ValueString() == "git" {
+		return source.Remote.ValueString()
+	}
+	return source.Type.ValueString() + ":" + source.Owner.ValueString() + "/" + source.Repository.ValueString()
+}
+
+// orphanedItemStrategyXML renders a DefaultOrphanedItemStrategy, using the Jenkins defaults when unset.
+func orphanedItemStrategyXML(model *orphanedItemStrategyModel, pluginVersions map[string]string) string {
+	prune, daysToKeep, numToKeep, abortBuilds := true, int64(-1), int64(-1), false
+	if model != nil {
+		prune = model.PruneDeadBranches.ValueBool()
+		daysToKeep = model.DaysToKeep.ValueInt64()
+		numToKeep = model.NumToKeep.ValueInt64()
+		abortBuilds = model.AbortBuilds.ValueBool()
+	}
+	return fmt.Sprintf(`  <orphanedItemStrategy class="com.cloudbees.hudson.plugins.folder.computed.DefaultOrphanedItemStrategy" plugin="%s">
+    <pruneDeadBranches>%t</pruneDeadBranches>
+    <daysToKeep>%d</daysToKeep>
+    <numToKeep>%d</numToKeep>
+    <abortBuilds>%t</abortBuilds>
+  </orphanedItemStrategy>`, escapeXML(pluginAttribute(pluginVersions, "cloudbees-folder")), prune, daysToKeep, numToKeep, abortBuilds)
+}
+
+// readOrphanedItemStrategy converts the strategy read from Jenkins, keeping the attribute
+// unset when it was not configured and Jenkins reports the defaults.
+func readOrphanedItemStrategy(config *orphanedItemStrategyConfig, prior *orphanedItemStrategyModel) *orphanedItemStrategyModel {
+	if config == nil {
+		return prior
+	}
+	isDefault := config.PruneDeadBranches && config.DaysToKeep == -1 && config.NumToKeep == -1 && !config.AbortBuilds
+	if prior == nil && isDefault {
+		return nil
+	}
+	return &orphanedItemStrategyModel{
+		PruneDeadBranches: types.BoolValue(config.PruneDeadBranches),
+		DaysToKeep:        types.Int64Value(config.DaysToKeep),
+		NumToKeep:         types.Int64Value(config.NumToKeep),
+		AbortBuilds:       types.BoolValue(config.AbortBuilds),
+	}
+}
+
+// periodicFolderTriggerXML renders the trigger that periodically re-indexes a computed folder.
+// The cron spec only controls how often Jenkins checks whether the interval has elapsed.
+func periodicFolderTriggerXML(intervalMinutes int64, pluginVersions map[string]string) string {
+	if intervalMinutes <= 0 {
+		return "  <triggers/>"
+	}
+	spec := "H * * * *"
+	if intervalMinutes < 60 {
+		spec = "* * * * *"
+	}
+	return fmt.Sprintf(`  <triggers>
+    <com.cloudbees.hudson.plugins.folder.computed.PeriodicFolderTrigger plugin="%s">
+      <spec>%s</spec>
+      <interval>%d</interval>
+    </com.cloudbees.hudson.plugins.folder.computed.PeriodicFolderTrigger>
+  </triggers>`, escapeXML(pluginAttribute(pluginVersions, "cloudbees-folder")), spec, intervalMinutes*60000)
+}
+
+// multibranchPlugins lists the plugins a multibranch pipeline's config.xml depends on, besides
+// the plugins providing its branch sources.
+var multibranchPlugins = []string{"workflow-multibranch", "branch-api", "cloudbees-folder"}
+
+// multibranchRequiredPlugins returns the plugins required by the planned multibranch pipeline.
+func multibranchRequiredPlugins(model *jenkinsMultibranchPipelineResourceModel) []string {
+	required := append([]string{}, multibranchPlugins...)
+	for _, source := range model.BranchSources {
+		required = append(required, scmKinds[source.Type.ValueString()].Plugin)
+	}
+	return required
+}
+
+// buildMultibranchConfigXML generates the config.xml of a multibranch pipeline from the resource model.
+func buildMultibranchConfigXML(model *jenkinsMultibranchPipelineResourceModel, pluginVersions map[string]string) string {
+	const projectClass = "org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject"
+
+	var sources strings.Builder
+	for i := range model.BranchSources {
+		source := &model.BranchSources[i]
+		kind := scmKinds[source.Type.ValueString()]
+
+		id := source.ID.ValueString()
+		if source.ID.IsNull() || id == "" {
+			id = defaultBranchSourceID(source)
+		}
+
+		var fields strings.Builder
+		fmt.Fprintf(&fields, "\n          <id>%s</id>", escapeXML(id))
+		switch source.Type.ValueString() {
+		case "git":
+			fmt.Fprintf(&fields, "\n          <remote>%s</remote>", escapeXML(source.Remote.ValueString()))
+		case "github":
+			if !source.ServerURL.IsNull() {
+				fmt.Fprintf(&fields, "\n          <apiUri>%s</apiUri>", escapeXML(source.ServerURL.ValueString()))
+			}
+		case "bitbucket":
+			serverURL := "https://bitbucket.org"
+			if !source.ServerURL.IsNull() {
+				serverURL = source.ServerURL.ValueString()
+			}
+			fmt.Fprintf(&fields, "\n          <serverUrl>%s</serverUrl>", escapeXML(serverURL))
+		}
+		fmt.Fprintf(&fields, "\n          <credentialsId>%s</credentialsId>", escapeXML(source.CredentialsID.ValueString()))
+		if source.Type.ValueString() != "git" {
+			fmt.Fprintf(&fields, "\n          <repoOwner>%s</repoOwner>", escapeXML(source.Owner.ValueString()))
+			fmt.Fprintf(&fields, "\n          <repository>%s</repository>", escapeXML(source.Repository.ValueString()))
+		}
+
+		fmt.Fprintf(&sources, `
+      <jenkins.branch.BranchSource>
+        <source class="%s" plugin="%s">%s
+          <traits>%s
+          </traits>
+        </source>
+        <strategy class="jenkins.branch.DefaultBranchPropertyStrategy">
+          <properties class="empty-list"/>
+        </strategy>
+      </jenkins.branch.BranchSource>`,
+			kind.SourceClass,
+			escapeXML(pluginAttribute(pluginVersions, kind.Plugin)),
+			fields.String(),
+			scmTraitsXML(kind, source.DiscoverBranches.ValueString(), source.DiscoverPullRequests.ValueString(), source.DiscoverForkPullRequests.ValueString(), source.DiscoverTags.ValueBool()),
+		)
+	}
+
+	return fmt.Sprintf(`<?xml version='1.1' encoding='UTF-8'?>
+<%[1]s plugin="%[7]s">
+  <actions/>
+  <description>%[2]s</description>
+  <properties/>
+  <folderViews class="jenkins.branch.MultiBranchProjectViewHolder" plugin="%[8]s">
+    <owner class="%[1]s" reference="../.."/>
+  </folderViews>
+  <healthMetrics/>
+  <icon class="jenkins.branch.MetadataActionFolderIcon" plugin="%[8]s">
+    <owner class="%[1]s" reference="../.."/>
+  </icon>
+%[3]s
+%[4]s
+  <disabled>false</disabled>
+  <sources class="jenkins.branch.MultiBranchProject$BranchSourceList" plugin="%[8]s">
+    <data>%[5]s
+    </data>
+    <owner class="%[1]s" reference="../.."/>
+  </sources>
+  <factory class="org.jenkinsci.plugins.workflow.multibranch.WorkflowBranchProjectFactory">
+    <owner class="%[1]s" reference="../.."/>
+    <scriptPath>%[6]s</scriptPath>
+  </factory>
+</%[1]s>`,
+		projectClass,
+		escapeXML(model.Description.ValueString()),
+		orphanedItemStrategyXML(model.OrphanedItemStrategy, pluginVersions),
+		periodicFolderTriggerXML(model.ScanIntervalMinutes.ValueInt64(), pluginVersions),
+		sources.String(),
+		escapeXML(model.ScriptPath.ValueString()),
+		escapeXML(pluginAttribute(pluginVersions, "workflow-multibranch")),
+		escapeXML(pluginAttribute(pluginVersions, "branch-api")),
+	)
+}
+
+// applyMultibranchConfig copies the multibranch configuration read from Jenkins into the resource model.
+func applyMultibranchConfig(configXML string, model *jenkinsMultibranchPipelineResourceModel) error {
+	config := &multibranchConfig{}
+	if err := unmarshalJenkinsXML(configXML, config); err != nil {
+		return fmt.Errorf("could not parse job config.xml: %s", err.Error())
+	}
+	if config.XMLName.Local != "org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProject" {
+		return fmt.Errorf("job is a %s, not a multibranch pipeline", config.XMLName.Local)
+	}
+
+	model.Description = types.StringValue(config.Description)
+	model.ScriptPath = types.StringValue(config.ScriptPath)
+	model.OrphanedItemStrategy = readOrphanedItemStrategy(config.Orphaned, model.OrphanedItemStrategy)
+
+	var interval int64
+	if config.Periodic != nil {
+		interval = config.Periodic.Interval / 60000
+	}
+	model.ScanIntervalMinutes = types.Int64Value(interval)
+
+	prior := model.BranchSources
+	sources := make([]multibranchSourceModel, 0, len(config.Sources))
+	for i, entry := range config.Sources {
+		source := entry.Source
+
+		sourceType := ""
+		for name, kind := range scmKinds {
+			if kind.SourceClass == source.Class {
+				sourceType = name
+			}
+		}
+		if sourceType == "" {
+			return fmt.Errorf("unsupported branch source class '%s'", source.Class)
+		}
+		kind := scmKinds[sourceType]
+
+		branches, pullRequests, forkPullRequests, tags := parseSCMTraits(kind, source.Traits.Items)
+		item := multibranchSourceModel{
+			ID:                       types.StringValue(source.ID),
+			Type:                     types.StringValue(sourceType),
+			Remote:                   optionalString(source.Remote),
+			Owner:                    optionalString(source.RepoOwner),
+			Repository:               optionalString(source.Repository),
+			ServerURL:                optionalString(source.APIURI + source.ServerURL),
+			CredentialsID:            optionalString(source.CredentialsID),
+			DiscoverBranches:         types.StringValue(branches),
+			DiscoverPullRequests:     types.StringValue(pullRequests),
+			DiscoverForkPullRequests: types.StringValue(forkPullRequests),
+			DiscoverTags:             types.BoolValue(tags),
+		}
+
+		// Keep defaulted attributes unset when they were not configured
+		var priorSource *multibranchSourceModel
+		if i < len(prior) {
+			priorSource = &prior[i]
+		}
+		if (priorSource == nil || priorSource.ID.IsNull()) && source.ID == defaultBranchSourceID(&item) {
+			item.ID = types.StringNull()
+		}
+		if (priorSource == nil || priorSource.ServerURL.IsNull()) && sourceType == "bitbucket" && source.ServerURL == "https://bitbucket.org" {
+			item.ServerURL = types.StringNull()
+		}
+
+		sources = append(sources, item)
+	}
+	model.BranchSources = sources
+	return nil
+}
+
+// Create a new multibranch pipeline job.
+func (r *jenkinsMultibranchPipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsMultibranchPipelineResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := plan.Name.ValueString()
+	folder := plan.Folder.ValueString()
+	parents := folderParents(folder)
+	fullName := strings.Join(append(parents, jobName), "/")
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, multibranchRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if job already exists (idempotency)
+	_, err := r.client.GetJob(ctx, jobName, parents...)
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Job Already Exists",
+			fmt.Sprintf("Jenkins job '%s' already exists. Consider importing it or using a different name.", fullName),
+		)
+		return
+	} else if !strings.Contains(err.Error(), "404") {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to check if job '%s' exists: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	job, err := r.client.CreateJobInFolder(ctx, buildMultibranchConfigXML(&plan, pluginVersions), jobName, parents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Creation Error",
+			fmt.Sprintf("Failed to create Jenkins multibranch pipeline '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	if plan.IndexOnCreate.ValueBool() {
+		// Building a computed folder schedules a branch indexing
+		httpResp, err := r.client.Requester.Post(ctx, job.Base+"/build", nil, nil, map[string]string{"delay": "0"})
+		if err == nil && httpResp.StatusCode >= http.StatusBadRequest {
+			err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Branch Indexing Not Started",
+				fmt.Sprintf("Jenkins multibranch pipeline '%s' was created, but branch indexing could not be started: %s", fullName, err.Error()),
+			)
+		}
+	}
+
+	// Read back the created job to ensure consistency
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyMultibranchConfig(configXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error After Create",
+			fmt.Sprintf("Failed to read created Jenkins multibranch pipeline '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fullName)
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins multibranch pipeline '%s' created successfully.", fullName)
+}
+
+// Read retrieves the current state of a multibranch pipeline job.
+func (r *jenkinsMultibranchPipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsMultibranchPipelineResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			// Job no longer exists in Jenkins, remove from Terraform state
+			resp.State.RemoveResource(ctx)
+			log.Printf("[INFO] Jenkins multibranch pipeline '%s' not found, removing from state.", fullName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error",
+			fmt.Sprintf("Failed to get Jenkins job details for '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyMultibranchConfig(configXML, &state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Config Read Error",
+			fmt.Sprintf("Failed to read Jenkins multibranch pipeline config for '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(jobName)
+	if folder != "" {
+		state.Folder = types.StringValue(folder)
+	}
+	if state.IndexOnCreate.IsNull() {
+		state.IndexOnCreate = types.BoolValue(false) // Not stored in Jenkins, e.g. after import
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins multibranch pipeline '%s' read successfully.", fullName)
+}
+
+// Update an existing multibranch pipeline job.
+func (r *jenkinsMultibranchPipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsMultibranchPipelineResourceModel
+	var state jenkinsMultibranchPipelineResourceModel
+
+	// Get the plan (desired state) and current state from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.Diagnostics.AddError(
+				"Jenkins Job Not Found For Update",
+				fmt.Sprintf("Cannot update job '%s' because it does not exist in Jenkins.", fullName),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error During Update Check",
+			fmt.Sprintf("Failed to check if job '%s' exists before update: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, multibranchRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := job.UpdateConfig(ctx, buildMultibranchConfigXML(&plan, pluginVersions)); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Update Error",
+			fmt.Sprintf("Failed to update Jenkins multibranch pipeline '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Re-fetch the job after update
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyMultibranchConfig(configXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error After Update",
+			fmt.Sprintf("Failed to re-read updated Jenkins multibranch pipeline '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins multibranch pipeline '%s' updated successfully.", fullName)
+}
+
+// Delete a multibranch pipeline job.
+func (r *jenkinsMultibranchPipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsMultibranchPipelineResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	// Check if job exists before attempting to delete (idempotency)
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[INFO] Jenkins multibranch pipeline '%s' not found (already deleted).", fullName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error Before Deletion",
+			fmt.Sprintf("Failed to check if job '%s' exists before deletion: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	if _, err := job.Delete(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Deletion Error",
+			fmt.Sprintf("Failed to delete Jenkins multibranch pipeline '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	log.Printf("[INFO] Jenkins multibranch pipeline '%s' deleted successfully.", fullName)
+}
+
+// ImportState allows importing existing multibranch pipelines into Terraform state.
+func (r *jenkinsMultibranchPipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The imported ID is the full name of the job, e.g. `team/my-service`.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,947 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsNodeResource{}
+var _ resource.ResourceWithImportState = &jenkinsNodeResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsNodeResource{}
+
+// errNodeNotFound is returned when Jenkins has no computer with the requested name.
+var errNodeNotFound = errors.New("node not found")
+
+// sshHostKeyStrategies maps the schema values of `host_key_verification` to the Jenkins strategy classes.
+var sshHostKeyStrategies = map[string]string{
+	"known_hosts":      "hudson.plugins.sshslaves.verifiers.KnownHostsFileKeyVerificationStrategy",
+	"manually_trusted": "hudson.plugins.sshslaves.verifiers.ManuallyTrustedKeyVerificationStrategy",
+	"non_verifying":    "hudson.plugins.sshslaves.verifiers.NonVerifyingKeyVerificationStrategy",
+}
+
+// NewJenkinsNodeResource is a helper function to simplify provider development.
+func NewJenkinsNodeResource() resource.Resource {
+	return &jenkinsNodeResource{}
+}
+
+// jenkinsNodeResource defines the resource implementation.
+type jenkinsNodeResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsNodeResourceModel describes the resource data model for a permanent Jenkins agent.
+type jenkinsNodeResourceModel struct {
+	ID              types.String              `tfsdk:"id"`                  // Unique identifier (node name)
+	Name            types.String              `tfsdk:"name"`                // Name of the node
+	Description     types.String              `tfsdk:"description"`         // Description of the node
+	RemoteFS        types.String              `tfsdk:"remote_fs"`           // Remote root directory on the agent
+	NumExecutors    types.Int64               `tfsdk:"num_executors"`       // Number of executors
+	Labels          types.Set                 `tfsdk:"labels"`              // Labels assigned to the node
+	Mode            types.String              `tfsdk:"mode"`                // Usage mode (NORMAL or EXCLUSIVE)
+	Environment     types.Map                 `tfsdk:"environment"`         // Node environment variables
+	SSHLauncher     *nodeSSHLauncherModel     `tfsdk:"ssh_launcher"`        // SSH launcher settings
+	InboundLauncher *nodeInboundLauncherModel `tfsdk:"inbound_launcher"`    // Inbound (JNLP) launcher settings
+	AgentSecret     types.String              `tfsdk:"agent_secret"`        // Secret used by inbound agents to connect (computed)
+	TempOffline     types.Bool                `tfsdk:"temporarily_offline"` // Whether the node is marked temporarily offline
+	OfflineReason   types.String              `tfsdk:"offline_reason"`      // Reason shown while the node is temporarily offline
+}
+
+// nodeSSHLauncherModel describes the settings of the SSH Build Agents launcher.
+type nodeSSHLauncherModel struct {
+	Host                types.String `tfsdk:"host"`
+	Port                types.Int64  `tfsdk:"port"`
+	CredentialsID       types.String `tfsdk:"credentials_id"`
+	HostKeyVerification types.String `tfsdk:"host_key_verification"`
+	JavaPath            types.String `tfsdk:"java_path"`
+	JVMOptions          types.String `tfsdk:"jvm_options"`
+	LaunchTimeout       types.Int64  `tfsdk:"launch_timeout_seconds"`
+	MaxNumRetries       types.Int64  `tfsdk:"max_num_retries"`
+	RetryWaitTime       types.Int64  `tfsdk:"retry_wait_time"`
+}
+
+// nodeInboundLauncherModel describes the settings of the inbound (JNLP) launcher.
+type nodeInboundLauncherModel struct {
+	WebSocket types.Bool   `tfsdk:"web_socket"`
+	WorkDir   types.String `tfsdk:"work_dir"`
+}
+
+// nodeConfig mirrors the parts of a DumbSlave config.xml managed by this provider.
+type nodeConfig struct {
+	XMLName      xml.Name `xml:"slave"`
+	Name         string   `xml:"name"`
+	Description  string   `xml:"description"`
+	RemoteFS     string   `xml:"remoteFS"`
+	NumExecutors int64    `xml:"numExecutors"`
+	Mode         string   `xml:"mode"`
+	Label        string   `xml:"label"`
+	Launcher     struct {
+		Class                string `xml:"class,attr"`
+		Host                 string `xml:"host"`
+		Port                 int64  `xml:"port"`
+		CredentialsID        string `xml:"credentialsId"`
+		JavaPath             string `xml:"javaPath"`
+		JVMOptions           string `xml:"jvmOptions"`
+		LaunchTimeoutSeconds int64  `xml:"launchTimeoutSeconds"`
+		MaxNumRetries        int64  `xml:"maxNumRetries"`
+		RetryWaitTime        int64  `xml:"retryWaitTime"`
+		HostKeyStrategy      struct {
+			Class string `xml:"class,attr"`
+		} `xml:"sshHostKeyVerificationStrategy"`
+		WebSocket       bool `xml:"webSocket"`
+		WorkDirSettings struct {
+			Disabled    bool   `xml:"disabled"`
+			WorkDirPath string `xml:"workDirPath"`
+		} `xml:"workDirSettings"`
+	} `xml:"launcher"`
+	EnvVars []string `xml:"nodeProperties>hudson.slaves.EnvironmentVariablesNodeProperty>envVars>tree-map>string"`
+
+	AgentSecret        string `xml:"-"` // Read from the agent JNLP file, not from config.xml
+	TemporarilyOffline bool   `xml:"-"` // Read from the computer API, not from config.xml
+	OfflineReason      string `xml:"-"` // Read from the computer API, not from config.xml
+}
+
+// agentJNLP mirrors the JNLP file served to inbound agents. The first argument is the agent secret.
+type agentJNLP struct {
+	Arguments []string `xml:"application-desc>argument"`
+}
+
+// agentSecretPlanModifier keeps the planned agent secret stable unless the launcher type changes.
+type agentSecretPlanModifier struct{}
+
+func (m agentSecretPlanModifier) Description(ctx context.Context) string {
+	return "Uses the prior agent secret unless the node switches between SSH and inbound launchers."
+}
+
+func (m agentSecretPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m agentSecretPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	var planSSH, stateSSH types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ssh_launcher"), &planSSH)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("ssh_launcher"), &stateSSH)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The secret is derived from the node name, it only appears or disappears with the inbound launcher
+	if planSSH.IsNull() == stateSSH.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsNodeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node" // e.g., jenkins_node
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsNodeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a permanent Jenkins agent launched over SSH or connecting inbound.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The unique identifier (name) of the Jenkins node.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the Jenkins node.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the Jenkins node.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"remote_fs": schema.StringAttribute{
+				MarkdownDescription: "The remote root directory of the agent (e.g., `/home/jenkins`).",
+				Required:            true,
+			},
+			"num_executors": schema.Int64Attribute{
+				MarkdownDescription: "The number of executors on the node. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "The labels assigned to the node.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The usage mode of the node, `NORMAL` (use as much as possible) or `EXCLUSIVE` (only build jobs with matching label expressions). Defaults to `NORMAL`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("NORMAL"),
+			},
+			"environment": schema.MapAttribute{
+				MarkdownDescription: "Environment variables defined on the node.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ssh_launcher": schema.SingleNestedAttribute{
+				MarkdownDescription: "Launch the agent over SSH. Requires the SSH Build Agents plugin. Conflicts with `inbound_launcher`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The host name or IP address of the agent.",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The SSH port. Defaults to `22`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(22),
+					},
+					"credentials_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the SSH credentials used to connect.",
+						Required:            true,
+					},
+					"host_key_verification": schema.StringAttribute{
+						MarkdownDescription: "The host key verification strategy: `known_hosts`, `manually_trusted` or `non_verifying`. Defaults to `known_hosts`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("known_hosts"),
+					},
+					"java_path": schema.StringAttribute{
+						MarkdownDescription: "The path to the Java executable on the agent.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"jvm_options": schema.StringAttribute{
+						MarkdownDescription: "Additional JVM options for the agent process.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+					"launch_timeout_seconds": schema.Int64Attribute{
+						MarkdownDescription: "The connection timeout in seconds. Defaults to `60`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(60),
+					},
+					"max_num_retries": schema.Int64Attribute{
+						MarkdownDescription: "The number of connection retries. Defaults to `10`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(10),
+					},
+					"retry_wait_time": schema.Int64Attribute{
+						MarkdownDescription: "The number of seconds to wait between retries. Defaults to `15`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(15),
+					},
+				},
+			},
+			"inbound_launcher": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for an inbound (JNLP) agent. Inbound is the default launcher when `ssh_launcher` is not set.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"web_socket": schema.BoolAttribute{
+						MarkdownDescription: "Connect the agent over WebSocket instead of the TCP agent port.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"work_dir": schema.StringAttribute{
+						MarkdownDescription: "A custom remoting work directory. Defaults to the remote root directory.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(""),
+					},
+				},
+			},
+			"agent_secret": schema.StringAttribute{
+				MarkdownDescription: "The secret an inbound agent uses to connect to the controller. Empty for SSH agents.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					agentSecretPlanModifier{},
+				},
+			},
+			"temporarily_offline": schema.BoolAttribute{
+				MarkdownDescription: "Mark the node temporarily offline so it accepts no new builds. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"offline_reason": schema.StringAttribute{
+				MarkdownDescription: "The reason shown while the node is temporarily offline. Requires `temporarily_offline`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks attribute combinations that the schema cannot express.
+func (r *jenkinsNodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsNodeResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.SSHLauncher != nil && config.InboundLauncher != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh_launcher"),
+			"Conflicting Launcher Configuration",
+			"Only one of 'ssh_launcher' or 'inbound_launcher' can be set.",
+		)
+	}
+
+	if !config.Mode.IsNull() && !config.Mode.IsUnknown() {
+		if mode := config.Mode.ValueString(); mode != "NORMAL" && mode != "EXCLUSIVE" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("mode"),
+				"Invalid Node Mode",
+				fmt.Sprintf("Mode must be 'NORMAL' or 'EXCLUSIVE', got: '%s'.", mode),
+			)
+		}
+	}
+
+	if !config.OfflineReason.IsNull() && !config.OfflineReason.IsUnknown() && config.OfflineReason.ValueString() != "" &&
+		!config.TempOffline.IsUnknown() && !config.TempOffline.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("offline_reason"),
+			"Offline Reason Without Offline Node",
+			"'offline_reason' can only be set when 'temporarily_offline' is true.",
+		)
+	}
+
+	if config.SSHLauncher != nil && !config.SSHLauncher.HostKeyVerification.IsNull() && !config.SSHLauncher.HostKeyVerification.IsUnknown() {
+		if _, ok := sshHostKeyStrategies[config.SSHLauncher.HostKeyVerification.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ssh_launcher").AtName("host_key_verification"),
+				"Invalid Host Key Verification Strategy",
+				fmt.Sprintf("Host key verification must be one of 'known_hosts', 'manually_trusted' or 'non_verifying', got: '%s'.", config.SSHLauncher.HostKeyVerification.ValueString()),
+			)
+		}
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsNodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// nodeExists reports whether a Jenkins computer exists. Unlike gojenkins' GetNode, it tells a
+// missing node apart from other failures, such as authentication or server errors.
+func nodeExists(ctx context.Context, client *gojenkins.Jenkins, name string) (bool, error) {
+	httpResp, err := client.Requester.GetJSON(ctx, nodeURL(name), &struct{}{}, map[string]string{
+		"tree": "displayName",
+	})
+	if err != nil {
+		return false, err
+	}
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+}
+
+// nodeURL returns the API path of a Jenkins computer.
+func nodeURL(name string) string {
+	return "/computer/" + url.PathEscape(name)
+}
+
+// nodeRequiredPlugins returns the plugins required by the planned node.
+func nodeRequiredPlugins(model *jenkinsNodeResourceModel) []string {
+	if model.SSHLauncher != nil {
+		return []string{"ssh-slaves"}
+	}
+	return nil
+}
+
+// buildNodeConfigXML generates the config.xml of a permanent agent from the resource model.
+func buildNodeConfigXML(ctx context.Context, model *jenkinsNodeResourceModel, pluginVersions map[string]string) (string, error) {
+	var labels []string
+	if !model.Labels.IsNull() && !model.Labels.IsUnknown() {
+		if diags := model.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
+			return "", fmt.Errorf("could not read labels")
+		}
+		sort.Strings(labels)
+	}
+
+	var launcher string
+	if model.SSHLauncher != nil {
+		ssh := model.SSHLauncher
+		launcher = fmt.Sprintf(`  <launcher class="hudson.plugins.sshslaves.SSHLauncher" plugin="%s">
+    <host>%s</host>
+    <port>%d</port>
+    <credentialsId>%s</credentialsId>
+    <javaPath>%s</javaPath>
+    <jvmOptions>%s</jvmOptions>
+    <launchTimeoutSeconds>%d</launchTimeoutSeconds>
+    <maxNumRetries>%d</maxNumRetries>
+    <retryWaitTime>%d</retryWaitTime>
+    <sshHostKeyVerificationStrategy class="%s"/>
+  </launcher>`,
+			escapeXML(pluginAttribute(pluginVersions, "ssh-slaves")),
+			escapeXML(ssh.Host.ValueString()),
+			ssh.Port.ValueInt64(),
+			escapeXML(ssh.CredentialsID.ValueString()),
+			escapeXML(ssh.JavaPath.ValueString()),
+			escapeXML(ssh.JVMOptions.ValueString()),
+			ssh.LaunchTimeout.ValueInt64(),
+			ssh.MaxNumRetries.ValueInt64(),
+			ssh.RetryWaitTime.ValueInt64(),
+			sshHostKeyStrategies[ssh.HostKeyVerification.ValueString()],
+		)
+	} else {
+		var webSocket bool
+		var workDir string
+		if model.InboundLauncher != nil {
+			webSocket = model.InboundLauncher.WebSocket.ValueBool()
+			workDir = model.InboundLauncher.WorkDir.ValueString()
+		}
+		launcher = fmt.Sprintf(`  <launcher class="hudson.slaves.JNLPLauncher">
+    <workDirSettings>
+      <disabled>false</disabled>
+      <workDirPath>%s</workDirPath>
+      <internalDir>remoting</internalDir>
+      <failIfWorkDirIsMissing>false</failIfWorkDirIsMissing>
+    </workDirSettings>
+    <webSocket>%t</webSocket>
+  </launcher>`, escapeXML(workDir), webSocket)
+	}
+
+	var nodeProperties string
+	if !model.Environment.IsNull() && !model.Environment.IsUnknown() {
+		env := map[string]string{}
+		if diags := model.Environment.ElementsAs(ctx, &env, false); diags.HasError() {
+			return "", fmt.Errorf("could not read environment variables")
+		}
+		keys := make([]string, 0, len(env))
+		for k := range env {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var entries strings.Builder
+		for _, k := range keys {
+			fmt.Fprintf(&entries, "\n          <string>%s</string>\n          <string>%s</string>", escapeXML(k), escapeXML(env[k]))
+		}
+		nodeProperties = fmt.Sprintf(`
+    <hudson.slaves.EnvironmentVariablesNodeProperty>
+      <envVars serialization="custom">
+        <unserializable-parents/>
+        <tree-map>
+          <default>
+            <comparator class="java.lang.String$CaseInsensitiveComparator"/>
+          </default>
+          <int>%d</int>%s
+        </tree-map>
+      </envVars>
+    </hudson.slaves.EnvironmentVariablesNodeProperty>
+  `, len(keys), entries.String())
+	}
+
+	configXML := fmt.Sprintf(`<?xml version='1.1' encoding='UTF-8'?>
+<slave>
+  <name>%s</name>
+  <description>%s</description>
+  <remoteFS>%s</remoteFS>
+  <numExecutors>%d</numExecutors>
+  <mode>%s</mode>
+  <retentionStrategy class="hudson.slaves.RetentionStrategy$Always"/>
+%s
+  <label>%s</label>
+  <nodeProperties>%s</nodeProperties>
+</slave>`,
+		escapeXML(model.Name.ValueString()),
+		escapeXML(model.Description.ValueString()),
+		escapeXML(model.RemoteFS.ValueString()),
+		model.NumExecutors.ValueInt64(),
+		model.Mode.ValueString(),
+		launcher,
+		escapeXML(strings.Join(labels, " ")),
+		nodeProperties,
+	)
+	return configXML, nil
+}
+
+// readNodeConfig fetches and decodes the config.xml of a Jenkins node.
+func readNodeConfig(ctx context.Context, client *gojenkins.Jenkins, name string) (*nodeConfig, error) {
+	var raw string
+	httpResp, err := client.Requester.GetXML(ctx, nodeURL(name)+"/config.xml", &raw, nil)
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, errNodeNotFound
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+
+	config := &nodeConfig{}
+	if err := unmarshalJenkinsXML(raw, config); err != nil {
+		return nil, fmt.Errorf("could not parse node config.xml: %s", err.Error())
+	}
+
+	node, err := client.GetNode(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("could not read node status: %s", err.Error())
+	}
+	config.TemporarilyOffline = node.Raw.TemporarilyOffline
+	// Jenkins keeps the cause of disconnections, which is not a reason set by the user
+	config.OfflineReason = ""
+	if node.Raw.TemporarilyOffline {
+		config.OfflineReason = node.Raw.OfflineCauseReason
+	}
+
+	if strings.HasSuffix(config.Launcher.Class, "JNLPLauncher") {
+		config.AgentSecret, err = readAgentSecret(ctx, client, name)
+		if err != nil {
+			return nil, fmt.Errorf("could not read agent secret: %s", err.Error())
+		}
+	}
+	return config, nil
+}
+
+// readAgentSecret extracts the secret of an inbound agent from its JNLP file. Older
+// controllers only serve slave-agent.jnlp, so it is used as a fallback.
+func readAgentSecret(ctx context.Context, client *gojenkins.Jenkins, name string) (string, error) {
+	var lastStatus int
+	for _, file := range []string{"jenkins-agent.jnlp", "slave-agent.jnlp"} {
+		var raw string
+		httpResp, err := client.Requester.Get(ctx, nodeURL(name)+"/"+file, &raw, nil)
+		if err != nil {
+			return "", err
+		}
+		lastStatus = httpResp.StatusCode
+		if httpResp.StatusCode == http.StatusNotFound {
+			continue
+		}
+		if httpResp.StatusCode != http.StatusOK {
+			break
+		}
+
+		jnlp := &agentJNLP{}
+		if err := unmarshalJenkinsXML(raw, jnlp); err != nil {
+			return "", fmt.Errorf("could not parse %s: %s", file, err.Error())
+		}
+		if len(jnlp.Arguments) == 0 {
+			return "", fmt.Errorf("%s does not contain an agent secret", file)
+		}
+		return jnlp.Arguments[0], nil
+	}
+	return "", fmt.Errorf("Jenkins responded with status %d", lastStatus)
+}
+
+// updateNodeConfig uploads a new config.xml for a Jenkins node.
+func updateNodeConfig(ctx context.Context, client *gojenkins.Jenkins, name string, configXML string) error {
+	httpResp, err := client.Requester.PostXML(ctx, nodeURL(name)+"/config.xml", configXML, nil, nil)
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return nil
+}
+
+// setNodeOfflineState marks a node temporarily offline or brings it back online through
+// toggleOffline, and updates the reason of an already offline node through changeOfflineCause.
+func setNodeOfflineState(ctx context.Context, client *gojenkins.Jenkins, name string, offline bool, reason string) error {
+	node, err := client.GetNode(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if node.Raw.TemporarilyOffline != offline {
+		if _, err := node.ToggleTemporarilyOffline(ctx, reason); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if offline && node.Raw.OfflineCauseReason != reason {
+		httpResp, err := client.Requester.Post(ctx, nodeURL(name)+"/changeOfflineCause", nil, nil, map[string]string{
+			"offlineMessage": reason,
+		})
+		if err != nil {
+			return err
+		}
+		if httpResp.StatusCode != http.StatusOK {
+			return fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// applyNodeConfig copies the node configuration read from Jenkins into the resource model.
+func applyNodeConfig(ctx context.Context, config *nodeConfig, model *jenkinsNodeResourceModel) error {
+	model.ID = types.StringValue(config.Name)
+	model.Name = types.StringValue(config.Name)
+	model.Description = types.StringValue(config.Description)
+	model.RemoteFS = types.StringValue(config.RemoteFS)
+	model.NumExecutors = types.Int64Value(config.NumExecutors)
+	model.Mode = types.StringValue(config.Mode)
+	model.AgentSecret = types.StringValue(config.AgentSecret)
+	model.TempOffline = types.BoolValue(config.TemporarilyOffline)
+	model.OfflineReason = types.StringValue(config.OfflineReason)
+
+	labels := strings.Fields(config.Label)
+	if len(labels) > 0 || !model.Labels.IsNull() {
+		labelSet, diags := types.SetValueFrom(ctx, types.StringType, labels)
+		if diags.HasError() {
+			return fmt.Errorf("could not convert labels")
+		}
+		model.Labels = labelSet
+	}
+
+	// The tree-map serializes each variable as a key/value pair of <string> elements
+	env := map[string]string{}
+	for i := 0; i+1 < len(config.EnvVars); i += 2 {
+		env[config.EnvVars[i]] = config.EnvVars[i+1]
+	}
+	if len(env) > 0 || !model.Environment.IsNull() {
+		envMap, diags := types.MapValueFrom(ctx, types.StringType, env)
+		if diags.HasError() {
+			return fmt.Errorf("could not convert environment variables")
+		}
+		model.Environment = envMap
+	}
+
+	launcher := config.Launcher
+	switch {
+	case strings.HasSuffix(launcher.Class, "SSHLauncher"):
+		hostKeyVerification := ""
+		for k, class := range sshHostKeyStrategies {
+			if class == launcher.HostKeyStrategy.Class {
+				hostKeyVerification = k
+			}
+		}
+		model.SSHLauncher = &nodeSSHLauncherModel{
+			Host:                types.StringValue(launcher.Host),
+			Port:                types.Int64Value(launcher.Port),
+			CredentialsID:       types.StringValue(launcher.CredentialsID),
+			HostKeyVerification: types.StringValue(hostKeyVerification),
+			JavaPath:            types.StringValue(launcher.JavaPath),
+			JVMOptions:          types.StringValue(launcher.JVMOptions),
+			LaunchTimeout:       types.Int64Value(launcher.LaunchTimeoutSeconds),
+			MaxNumRetries:       types.Int64Value(launcher.MaxNumRetries),
+			RetryWaitTime:       types.Int64Value(launcher.RetryWaitTime),
+		}
+		model.InboundLauncher = nil
+	default:
+		model.SSHLauncher = nil
+		// Inbound is the implicit default, only track the block when it is configured or non-default
+		if model.InboundLauncher != nil || launcher.WebSocket || launcher.WorkDirSettings.WorkDirPath != "" {
+			model.InboundLauncher = &nodeInboundLauncherModel{
+				WebSocket: types.BoolValue(launcher.WebSocket),
+				WorkDir:   types.StringValue(launcher.WorkDirSettings.WorkDirPath),
+			}
+		}
+	}
+	return nil
+}
+
+// cleanUpNode deletes a node whose creation failed part-way, so that the next apply
+// does not fail with "Node Already Exists".
+func cleanUpNode(ctx context.Context, client *gojenkins.Jenkins, name string) {
+	if _, err := client.DeleteNode(ctx, name); err != nil {
+		log.Printf("[WARN] Could not clean up Jenkins node '%s': %s", name, err.Error())
+	}
+}
+
+// Create a new permanent Jenkins node.
+func (r *jenkinsNodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsNodeResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeName := plan.Name.ValueString()
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, nodeRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configXML, err := buildNodeConfigXML(ctx, &plan, pluginVersions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Configuration Error",
+			fmt.Sprintf("Failed to build configuration for node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// Check if node already exists (idempotency)
+	exists, err := nodeExists(ctx, r.client, nodeName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to check if node '%s' exists: %s", nodeName, err.Error()),
+		)
+		return
+	}
+	if exists {
+		resp.Diagnostics.AddError(
+			"Node Already Exists",
+			fmt.Sprintf("Jenkins node '%s' already exists. Consider importing it or using a different name.", nodeName),
+		)
+		return
+	}
+
+	// Register the node with a default inbound launcher, then apply the full configuration
+	_, err = r.client.CreateNode(ctx, nodeName, int(plan.NumExecutors.ValueInt64()), plan.Description.ValueString(), plan.RemoteFS.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Creation Error",
+			fmt.Sprintf("Failed to create Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	if err := updateNodeConfig(ctx, r.client, nodeName, configXML); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Configuration Error",
+			fmt.Sprintf("Failed to configure Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		cleanUpNode(ctx, r.client, nodeName)
+		return
+	}
+
+	if err := setNodeOfflineState(ctx, r.client, nodeName, plan.TempOffline.ValueBool(), plan.OfflineReason.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Offline State Error",
+			fmt.Sprintf("Failed to set offline state of Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		cleanUpNode(ctx, r.client, nodeName)
+		return
+	}
+
+	// Read back the created node to ensure consistency
+	config, err := readNodeConfig(ctx, r.client, nodeName)
+	if err == nil {
+		err = applyNodeConfig(ctx, config, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error After Create",
+			fmt.Sprintf("Failed to read created Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		cleanUpNode(ctx, r.client, nodeName)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins node '%s' created successfully.", nodeName)
+}
+
+// Read retrieves the current state of a Jenkins node.
+func (r *jenkinsNodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsNodeResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeName := state.ID.ValueString()
+
+	config, err := readNodeConfig(ctx, r.client, nodeName)
+	if err != nil {
+		if errors.Is(err, errNodeNotFound) {
+			// Node no longer exists in Jenkins, remove from Terraform state
+			resp.State.RemoveResource(ctx)
+			log.Printf("[INFO] Jenkins node '%s' not found, removing from state.", nodeName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error",
+			fmt.Sprintf("Failed to read Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	if err := applyNodeConfig(ctx, config, &state); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error",
+			fmt.Sprintf("Failed to read Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins node '%s' read successfully.", nodeName)
+}
+
+// Update an existing Jenkins node.
+func (r *jenkinsNodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsNodeResourceModel
+	var state jenkinsNodeResourceModel
+
+	// Get the plan (desired state) and current state from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeName := state.ID.ValueString()
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, nodeRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configXML, err := buildNodeConfigXML(ctx, &plan, pluginVersions)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Configuration Error",
+			fmt.Sprintf("Failed to build configuration for node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	if err := updateNodeConfig(ctx, r.client, nodeName, configXML); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Update Error",
+			fmt.Sprintf("Failed to update Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	if err := setNodeOfflineState(ctx, r.client, nodeName, plan.TempOffline.ValueBool(), plan.OfflineReason.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Offline State Error",
+			fmt.Sprintf("Failed to set offline state of Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// Re-fetch the node after update
+	config, err := readNodeConfig(ctx, r.client, nodeName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error After Update",
+			fmt.Sprintf("Failed to re-read updated Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+	if err := applyNodeConfig(ctx, config, &plan); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Read Error After Update",
+			fmt.Sprintf("Failed to re-read updated Jenkins node '%s': %s", nodeName, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins node '%s' updated successfully.", nodeName)
+}
+
+// Delete a Jenkins node.
+func (r *jenkinsNodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsNodeResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nodeName := state.ID.ValueString()
+
+	// Check if node exists before attempting to delete (idempotency)
+	exists, err := nodeExists(ctx, r.client, nodeName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Node Deletion Error",
+			fmt.Sprintf("Failed to check if node '%s' exists before deletion: %s", nodeName, err.Error()),
+		)
+		return
+	}
+	if !exists {
+		log.Printf("[INFO] Jenkins node '%s' not found (already deleted).", nodeName)
+		return
+	}
+
+	deleted, err := r.client.DeleteNode(ctx, nodeName)
+	if err != nil || !deleted {
+		msg := "Jenkins did not confirm the deletion"
+		if err != nil {
+			msg = err.Error()
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins Node Deletion Error",
+			fmt.Sprintf("Failed to delete Jenkins node '%s': %s", nodeName, msg),
+		)
+		return
+	}
+
+	log.Printf("[INFO] Jenkins node '%s' deleted successfully.", nodeName)
+}
+
+// ImportState allows importing existing Jenkins nodes into Terraform state.
+func (r *jenkinsNodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The imported ID is the Jenkins node name.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,706 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// regexFilterTrait is the element of the trait filtering repositories by name.
+const regexFilterTrait = "jenkins.scm.impl.trait.RegexSCMSourceFilterTrait"
+
+// defaultScriptPath is the script path recognized when no project recognizers are configured.
+const defaultScriptPath = "Jenkinsfile"
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsOrganizationFolderResource{}
+var _ resource.ResourceWithImportState = &jenkinsOrganizationFolderResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsOrganizationFolderResource{}
+
+// scmNavigator describes how a hosting provider's navigator is serialized.
+type scmNavigator struct {
+	scmKind
+	NavigatorClass string // Class of the navigator, as an XML element
+	OwnerElement   string // Element holding the organization
+	ServerElement  string // Element holding the server, if any
+	DefaultServer  string // Server written when none is configured
+}
+
+// scmNavigators maps the values of the navigator `type` attribute to their serialization.
+var scmNavigators = map[string]scmNavigator{
+	"github": {
+		scmKind:        scmKinds["github"],
+		NavigatorClass: "org.jenkinsci.plugins.github__branch__source.GitHubSCMNavigator",
+		OwnerElement:   "repoOwner",
+		ServerElement:  "apiUri",
+	},
+	"gitlab": {
+		scmKind: scmKind{
+			Plugin:         "gitlab-branch-source",
+			TraitPrefix:    "io.jenkins.plugins.gitlabbranchsource.",
+			ForkTrust:      "io.jenkins.plugins.gitlabbranchsource.ForkMergeRequestDiscoveryTrait$TrustPermission",
+			Request:        "MergeRequest",
+			BranchStrategy: true,
+		},
+		NavigatorClass: "io.jenkins.plugins.gitlabbranchsource.GitLabSCMNavigator",
+		OwnerElement:   "projectOwner",
+		ServerElement:  "serverName",
+		DefaultServer:  "default",
+	},
+	"bitbucket": {
+		scmKind:        scmKinds["bitbucket"],
+		NavigatorClass: "com.cloudbees.jenkins.plugins.bitbucket.BitbucketSCMNavigator",
+		OwnerElement:   "repoOwner",
+		ServerElement:  "serverUrl",
+		DefaultServer:  "https://bitbucket.org",
+	},
+}
+
+// NewJenkinsOrganizationFolderResource is a helper function to simplify provider development.
+func NewJenkinsOrganizationFolderResource() resource.Resource {
+	return &jenkinsOrganizationFolderResource{}
+}
+
+// jenkinsOrganizationFolderResource defines the resource implementation.
+type jenkinsOrganizationFolderResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsOrganizationFolderResourceModel describes the resource data model for an organization folder.
+type jenkinsOrganizationFolderResourceModel struct {
+	ID                   types.String               `tfsdk:"id"`                     // Unique identifier (full job name)
+	Name                 types.String               `tfsdk:"name"`                   // Name of the organization folder
+	Folder               types.String               `tfsdk:"folder"`                 // Full name of the folder containing it
+	Description          types.String               `tfsdk:"description"`            // Description of the organization folder
+	Navigator            *scmNavigatorModel         `tfsdk:"navigator"`              // Organization to scan
+	ProjectRecognizers   []projectRecognizerModel   `tfsdk:"project_recognizers"`    // Recognizers turning repositories into jobs
+	ScanIntervalMinutes  types.Int64                `tfsdk:"scan_interval_minutes"`  // Periodic organization scan interval
+	OrphanedItemStrategy *orphanedItemStrategyModel `tfsdk:"orphaned_item_strategy"` // Handling of repositories that disappeared
+}
+
+// scmNavigatorModel describes the organization scanned by an organization folder.
+type scmNavigatorModel struct {
+	Type                     types.String `tfsdk:"type"`
+	Organization             types.String `tfsdk:"organization"`
+	Server                   types.String `tfsdk:"server"`
+	CredentialsID            types.String `tfsdk:"credentials_id"`
+	RepositoryRegex          types.String `tfsdk:"repository_regex"`
+	DiscoverBranches         types.String `tfsdk:"discover_branches"`
+	DiscoverPullRequests     types.String `tfsdk:"discover_pull_requests"`
+	DiscoverForkPullRequests types.String `tfsdk:"discover_fork_pull_requests"`
+	DiscoverTags             types.Bool   `tfsdk:"discover_tags"`
+}
+
+// projectRecognizerModel describes a pipeline project recognizer.
+type projectRecognizerModel struct {
+	ScriptPath types.String `tfsdk:"script_path"`
+}
+
+// navigatorConfig mirrors an SCM navigator in config.xml; its element name identifies the provider.
+type navigatorConfig struct {
+	XMLName       xml.Name
+	RepoOwner     string `xml:"repoOwner"`
+	ProjectOwner  string `xml:"projectOwner"`
+	APIURI        string `xml:"apiUri"`
+	ServerURL     string `xml:"serverUrl"`
+	ServerName    string `xml:"serverName"`
+	CredentialsID string `xml:"credentialsId"`
+	Traits        struct {
+		Items []scmTraitConfig `xml:",any"`
+	} `xml:"traits"`
+}
+
+// organizationFolderConfig mirrors the parts of an OrganizationFolder config.xml managed by this provider.
+type organizationFolderConfig struct {
+	XMLName     xml.Name
+	Description string                      `xml:"description"`
+	Orphaned    *orphanedItemStrategyConfig `xml:"orphanedItemStrategy"`
+	Periodic    *struct {
+		Interval int64 `xml:"interval"`
+	} `xml:"triggers>com.cloudbees.hudson.plugins.folder.computed.PeriodicFolderTrigger"`
+	Navigators struct {
+		Items []navigatorConfig `xml:",any"`
+	} `xml:"navigators"`
+	ProjectFactories []struct {
+		ScriptPath string `xml:"scriptPath"`
+	} `xml:"projectFactories>org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProjectFactory"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsOrganizationFolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_folder" // e.g., jenkins_organization_folder
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsOrganizationFolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jenkins organization folder, which creates a multibranch pipeline for every repository of an organization containing a pipeline script.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The full name of the organization folder, including its parent folder.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization folder.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The full name of the folder the organization folder is created in. Defaults to the Jenkins root.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the organization folder.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"navigator": schema.SingleNestedAttribute{
+				MarkdownDescription: "The organization to scan for repositories.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The hosting provider: `github` (GitHub Branch Source plugin), `gitlab` (GitLab Branch Source plugin) or `bitbucket` (Bitbucket Branch Source plugin).",
+						Required:            true,
+					},
+					"organization": schema.StringAttribute{
+						MarkdownDescription: "The organization, user, group or team owning the repositories.",
+						Required:            true,
+					},
+					"server": schema.StringAttribute{
+						MarkdownDescription: "The GitHub Enterprise API endpoint, the Bitbucket Server URL, or the name of the GitLab server configured in Jenkins. Defaults to the public service.",
+						Optional:            true,
+					},
+					"credentials_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the credentials used to scan the organization.",
+						Optional:            true,
+					},
+					"repository_regex": schema.StringAttribute{
+						MarkdownDescription: "A regular expression repository names must match to be scanned.",
+						Optional:            true,
+					},
+					"discover_branches": schema.StringAttribute{
+						MarkdownDescription: "Which branches to build: `all`, `exclude_prs` (branches not filed as pull requests), `only_prs` (branches filed as pull requests) or `none`. Defaults to `all`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("all"),
+					},
+					"discover_pull_requests": schema.StringAttribute{
+						MarkdownDescription: "Which revision of pull (merge) requests from origin repositories to build: `merge`, `head`, `both` or `none`. Defaults to `none`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("none"),
+					},
+					"discover_fork_pull_requests": schema.StringAttribute{
+						MarkdownDescription: "Which revision of pull (merge) requests from forks to build: `merge`, `head`, `both` or `none`. Only forks from trusted contributors may change the pipeline script. Defaults to `none`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("none"),
+					},
+					"discover_tags": schema.BoolAttribute{
+						MarkdownDescription: "Whether tags are discovered. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
+			"project_recognizers": schema.ListNestedAttribute{
+				MarkdownDescription: "The pipeline scripts whose presence turns a repository into a multibranch pipeline. Defaults to a single `Jenkinsfile` recognizer.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"script_path": schema.StringAttribute{
+							MarkdownDescription: "The path of the pipeline script in the repository.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"scan_interval_minutes": schema.Int64Attribute{
+				MarkdownDescription: "How often the organization is re-scanned when no webhook notifies Jenkins, in minutes. `0` disables periodic scanning. Defaults to `1440` (one day).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1440),
+			},
+			"orphaned_item_strategy": orphanedItemStrategySchema(),
+		},
+	}
+}
+
+// ValidateConfig checks the navigator settings against its type.
+func (r *jenkinsOrganizationFolderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsOrganizationFolderResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Navigator == nil {
+		return
+	}
+
+	navigatorPath := path.Root("navigator")
+	navigator := config.Navigator
+	if !navigator.Type.IsUnknown() {
+		if _, ok := scmNavigators[navigator.Type.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				navigatorPath.AtName("type"),
+				"Invalid Navigator Type",
+				fmt.Sprintf("Type must be one of 'github', 'gitlab' or 'bitbucket', got: '%s'.", navigator.Type.ValueString()),
+			)
+		}
+	}
+
+	if v := navigator.DiscoverBranches; !v.IsNull() && !v.IsUnknown() && v.ValueString() != "none" {
+		if _, ok := branchDiscoveryStrategies[v.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(navigatorPath.AtName("discover_branches"), "Invalid Branch Discovery", fmt.Sprintf("Unknown branch discovery strategy '%s'.", v.ValueString()))
+		}
+	}
+	for name, value := range map[string]types.String{
+		"discover_pull_requests":      navigator.DiscoverPullRequests,
+		"discover_fork_pull_requests": navigator.DiscoverForkPullRequests,
+	} {
+		if value.IsNull() || value.IsUnknown() || value.ValueString() == "none" {
+			continue
+		}
+		if _, ok := pullRequestDiscoveryStrategies[value.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(navigatorPath.AtName(name), "Invalid Pull Request Discovery", fmt.Sprintf("Unknown pull request discovery strategy '%s'.", value.ValueString()))
+		}
+	}
+
+	if config.ProjectRecognizers != nil && len(config.ProjectRecognizers) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_recognizers"),
+			"Missing Project Recognizers",
+			"At least one project recognizer is required, otherwise no repository is ever turned into a job. Omit the attribute to recognize a 'Jenkinsfile'.",
+		)
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsOrganizationFolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// organizationFolderPlugins lists the plugins an organization folder's config.xml depends on,
+// besides the plugin providing its navigator.
+var organizationFolderPlugins = []string{"branch-api", "workflow-multibranch", "cloudbees-folder"}
+
+// organizationFolderRequiredPlugins returns the plugins required by the planned organization folder.
+func organizationFolderRequiredPlugins(model *jenkinsOrganizationFolderResourceModel) []string {
+	required := append([]string{}, organizationFolderPlugins...)
+	if model.Navigator != nil {
+		required = append(required, scmNavigators[model.Navigator.Type.ValueString()].Plugin)
+		if !model.Navigator.RepositoryRegex.IsNull() {
+			required = append(required, "scm-api")
+		}
+	}
+	return required
+}
+
+// buildOrganizationFolderConfigXML generates the config.xml of an organization folder from the resource model.
+func buildOrganizationFolderConfigXML(model *jenkinsOrganizationFolderResourceModel, pluginVersions map[string]string) string {
+	const folderClass = "jenkins.branch.OrganizationFolder"
+
+	navigator := model.Navigator
+	kind := scmNavigators[navigator.Type.ValueString()]
+
+	var fields strings.Builder
+	fmt.Fprintf(&fields, "\n      <%[1]s>%[2]s</%[1]s>", kind.OwnerElement, escapeXML(navigator.Organization.ValueString()))
+	server := kind.DefaultServer
+	if !navigator.Server.IsNull() {
+		server = navigator.Server.ValueString()
+	}
+	if server != "" {
+		fmt.Fprintf(&fields, "\n      <%[1]s>%[2]s</%[1]s>", kind.ServerElement, escapeXML(server))
+	}
+	fmt.Fprintf(&fields, "\n      <credentialsId>%s</credentialsId>", escapeXML(navigator.CredentialsID.ValueString()))
+
+	traits := scmTraitsXML(kind.scmKind, navigator.DiscoverBranches.ValueString(), navigator.DiscoverPullRequests.ValueString(), navigator.DiscoverForkPullRequests.ValueString(), navigator.DiscoverTags.ValueBool())
+	if !navigator.RepositoryRegex.IsNull() {
+		traits += fmt.Sprintf("\n            <%[1]s plugin=\"%[3]s\">\n              <regex>%[2]s</regex>\n            </%[1]s>", regexFilterTrait, escapeXML(navigator.RepositoryRegex.ValueString()),
+			escapeXML(pluginAttribute(pluginVersions, "scm-api")))
+	}
+
+	recognizers := model.ProjectRecognizers
+	if len(recognizers) == 0 {
+		recognizers = []projectRecognizerModel{{ScriptPath: types.StringValue(defaultScriptPath)}}
+	}
+	var factories strings.Builder
+	for _, recognizer := range recognizers {
+		fmt.Fprintf(&factories, `
+    <org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProjectFactory plugin="%s">
+      <scriptPath>%s</scriptPath>
+    </org.jenkinsci.plugins.workflow.multibranch.WorkflowMultiBranchProjectFactory>`,
+			escapeXML(pluginAttribute(pluginVersions, "workflow-multibranch")), escapeXML(recognizer.ScriptPath.ValueString()))
+	}
+
+	return fmt.Sprintf(`<?xml version='1.1' encoding='UTF-8'?>
+<%[1]s plugin="%[10]s">
+  <actions/>
+  <description>%[2]s</description>
+  <properties/>
+  <folderViews class="jenkins.branch.OrganizationFolderViewHolder">
+    <owner reference="../.."/>
+  </folderViews>
+  <healthMetrics/>
+  <icon class="jenkins.branch.MetadataActionFolderIcon">
+    <owner class="%[1]s" reference="../.."/>
+  </icon>
+%[3]s
+%[4]s
+  <disabled>false</disabled>
+  <navigators>
+    <%[5]s plugin="%[6]s">%[7]s
+      <traits>%[8]s
+      </traits>
+    </%[5]s>
+  </navigators>
+  <projectFactories>%[9]s
+  </projectFactories>
+  <buildStrategies/>
+  <strategy class="jenkins.branch.DefaultBranchPropertyStrategy">
+    <properties class="empty-list"/>
+  </strategy>
+</%[1]s>`,
+		folderClass,
+		escapeXML(model.Description.ValueString()),
+		orphanedItemStrategyXML(model.OrphanedItemStrategy, pluginVersions),
+		periodicFolderTriggerXML(model.ScanIntervalMinutes.ValueInt64(), pluginVersions),
+		kind.NavigatorClass,
+		escapeXML(pluginAttribute(pluginVersions, kind.Plugin)),
+		fields.String(),
+		traits,
+		factories.String(),
+		escapeXML(pluginAttribute(pluginVersions, "branch-api")),
+	)
+}
+
+// applyOrganizationFolderConfig copies the organization folder configuration read from Jenkins into the resource model.
+func applyOrganizationFolderConfig(configXML string, model *jenkinsOrganizationFolderResourceModel) error {
+	config := &organizationFolderConfig{}
+	if err := unmarshalJenkinsXML(configXML, config); err != nil {
+		return fmt.Errorf("could not parse job config.xml: %s", err.Error())
+	}
+	if config.XMLName.Local != "jenkins.branch.OrganizationFolder" {
+		return fmt.Errorf("job is a %s, not an organization folder", config.XMLName.Local)
+	}
+	if len(config.Navigators.Items) != 1 {
+		return fmt.Errorf("expected a single navigator, found %d", len(config.Navigators.Items))
+	}
+
+	model.Description = types.StringValue(config.Description)
+	model.OrphanedItemStrategy = readOrphanedItemStrategy(config.Orphaned, model.OrphanedItemStrategy)
+
+	var interval int64
+	if config.Periodic != nil {
+		interval = config.Periodic.Interval / 60000
+	}
+	model.ScanIntervalMinutes = types.Int64Value(interval)
+
+	// Navigator
+	navigatorXML := config.Navigators.Items[0]
+	navigatorType := ""
+	for name, kind := range scmNavigators {
+		if kind.NavigatorClass == navigatorXML.XMLName.Local {
+			navigatorType = name
+		}
+	}
+	if navigatorType == "" {
+		return fmt.Errorf("unsupported navigator '%s'", navigatorXML.XMLName.Local)
+	}
+	kind := scmNavigators[navigatorType]
+
+	prior := model.Navigator
+	branches, pullRequests, forkPullRequests, tags := parseSCMTraits(kind.scmKind, navigatorXML.Traits.Items)
+	navigator := &scmNavigatorModel{
+		Type:                     types.StringValue(navigatorType),
+		Organization:             types.StringValue(navigatorXML.RepoOwner + navigatorXML.ProjectOwner),
+		Server:                   optionalString(navigatorXML.APIURI + navigatorXML.ServerURL + navigatorXML.ServerName),
+		CredentialsID:            optionalString(navigatorXML.CredentialsID),
+		RepositoryRegex:          types.StringNull(),
+		DiscoverBranches:         types.StringValue(branches),
+		DiscoverPullRequests:     types.StringValue(pullRequests),
+		DiscoverForkPullRequests: types.StringValue(forkPullRequests),
+		DiscoverTags:             types.BoolValue(tags),
+	}
+	for _, trait := range navigatorXML.Traits.Items {
+		if trait.XMLName.Local == regexFilterTrait {
+			navigator.RepositoryRegex = types.StringValue(trait.Regex)
+		}
+	}
+	// Keep the server unset when it was not configured and Jenkins reports the default
+	if (prior == nil || prior.Server.IsNull()) && navigator.Server.ValueString() == kind.DefaultServer {
+		navigator.Server = types.StringNull()
+	}
+	model.Navigator = navigator
+
+	// Project recognizers
+	recognizers := make([]projectRecognizerModel, 0, len(config.ProjectFactories))
+	for _, factory := range config.ProjectFactories {
+		recognizers = append(recognizers, projectRecognizerModel{ScriptPath: types.StringValue(factory.ScriptPath)})
+	}
+	if model.ProjectRecognizers == nil && len(recognizers) == 1 && recognizers[0].ScriptPath.ValueString() == defaultScriptPath {
+		recognizers = nil
+	}
+	model.ProjectRecognizers = recognizers
+
+	return nil
+}
+
+// Create a new organization folder.
+func (r *jenkinsOrganizationFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsOrganizationFolderResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobName := plan.Name.ValueString()
+	parents := folderParents(plan.Folder.ValueString())
+	fullName := strings.Join(append(parents, jobName), "/")
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, organizationFolderRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check if job already exists (idempotency)
+	_, err := r.client.GetJob(ctx, jobName, parents...)
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Job Already Exists",
+			fmt.Sprintf("Jenkins job '%s' already exists. Consider importing it or using a different name.", fullName),
+		)
+		return
+	} else if !strings.Contains(err.Error(), "404") {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to check if job '%s' exists: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	job, err := r.client.CreateJobInFolder(ctx, buildOrganizationFolderConfigXML(&plan, pluginVersions), jobName, parents...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Creation Error",
+			fmt.Sprintf("Failed to create Jenkins organization folder '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Read back the created job to ensure consistency
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyOrganizationFolderConfig(configXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error After Create",
+			fmt.Sprintf("Failed to read created Jenkins organization folder '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(fullName)
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins organization folder '%s' created successfully.", fullName)
+}
+
+// Read retrieves the current state of an organization folder.
+func (r *jenkinsOrganizationFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsOrganizationFolderResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			// Job no longer exists in Jenkins, remove from Terraform state
+			resp.State.RemoveResource(ctx)
+			log.Printf("[INFO] Jenkins organization folder '%s' not found, removing from state.", fullName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error",
+			fmt.Sprintf("Failed to get Jenkins job details for '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyOrganizationFolderConfig(configXML, &state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Config Read Error",
+			fmt.Sprintf("Failed to read Jenkins organization folder config for '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(jobName)
+	if folder != "" {
+		state.Folder = types.StringValue(folder)
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins organization folder '%s' read successfully.", fullName)
+}
+
+// Update an existing organization folder.
+func (r *jenkinsOrganizationFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsOrganizationFolderResourceModel
+	var state jenkinsOrganizationFolderResourceModel
+
+	// Get the plan (desired state) and current state from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			resp.Diagnostics.AddError(
+				"Jenkins Job Not Found For Update",
+				fmt.Sprintf("Cannot update job '%s' because it does not exist in Jenkins.", fullName),
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error During Update Check",
+			fmt.Sprintf("Failed to check if job '%s' exists before update: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, organizationFolderRequiredPlugins(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := job.UpdateConfig(ctx, buildOrganizationFolderConfigXML(&plan, pluginVersions)); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Update Error",
+			fmt.Sprintf("Failed to update Jenkins organization folder '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Re-fetch the job after update
+	configXML, err := job.GetConfig(ctx)
+	if err == nil {
+		err = applyOrganizationFolderConfig(configXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Read Error After Update",
+			fmt.Sprintf("Failed to re-read updated Jenkins organization folder '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins organization folder '%s' updated successfully.", fullName)
+}
+
+// Delete an organization folder and the jobs it created.
+func (r *jenkinsOrganizationFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsOrganizationFolderResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullName := state.ID.ValueString()
+	folder, jobName := splitJobFullName(fullName)
+
+	// Check if job exists before attempting to delete (idempotency)
+	job, err := r.client.GetJob(ctx, jobName, folderParents(folder)...)
+	if err != nil {
+		if strings.Contains(err.Error(), "404") {
+			log.Printf("[INFO] Jenkins organization folder '%s' not found (already deleted).", fullName)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Client Error Before Deletion",
+			fmt.Sprintf("Failed to check if job '%s' exists before deletion: %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	if _, err := job.Delete(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Job Deletion Error",
+			fmt.Sprintf("Failed to delete Jenkins organization folder '%s': %s", fullName, err.Error()),
+		)
+		return
+	}
+
+	log.Printf("[INFO] Jenkins organization folder '%s' deleted successfully.", fullName)
+}
+
+// ImportState allows importing existing organization folders into Terraform state.
+func (r *jenkinsOrganizationFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The imported ID is the full name of the organization folder, e.g. `github/my-org`.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -97,24 +97,26 @@ func (r *jenkinsPipelineResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+// pipelinePlugins lists the plugins a Pipeline job's config.xml depends on.
+var pipelinePlugins = []string{"workflow-job", "workflow-cps"}
+
 // buildPipelineConfigXML generates the XML configuration for a Jenkins Pipeline job.
-func buildPipelineConfigXML(description, groovyScript string) string {
-	// This is a basic template for a Pipeline job's config.xml.
-	// Plugin versions (e.g., workflow-job, workflow-cps) might need to be adjusted
-	// depending on your Jenkins setup, but gojenkins often handles this implicitly.
-	// For simplicity, we use common placeholder plugin versions.
+func buildPipelineConfigXML(description, groovyScript string, pluginVersions map[string]string) string {
+	// This is a basic template for a Pipeline job's config.xml. The plugin attributes carry the
+	// versions installed on the controller when they are known, as Jenkins itself writes them.
 	configXML := fmt.Sprintf(`<?xml version='1.1' encoding='UTF-8'?>
-<flow-definition plugin="workflow-job@1254.v3f669a_b_a_083a_">
+<flow-definition plugin="%s">
   <description>%s</description>
   <keepDependencies>false</keepDependencies>
   <properties/>
-  <definition class="org.jenkinsci.plugins.workflow.cps.CpsFlowDefinition" plugin="workflow-cps@2807.v39e1503c779e">
+  <definition class="org.jenkinsci.plugins.workflow.cps.CpsFlowDefinition" plugin="%s">
     <script><![CDATA[%s]]></script>
     <sandbox>true</sandbox>
   </definition>
   <triggers/>
   <disabled>false</disabled>
-</flow-definition>`, description, groovyScript)
+</flow-definition>`, escapeXML(pluginAttribute(pluginVersions, "workflow-job")), description,
+		escapeXML(pluginAttribute(pluginVersions, "workflow-cps")), groovyScript)
 	return configXML
 }
 
@@ -174,8 +176,14 @@ func (r *jenkinsPipelineResource) Create(ctx context.Context, req resource.Creat
 	description := plan.Description.ValueString()
 	groovyScript := plan.GroovyScript.ValueString()
 
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, pipelinePlugins...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Construct the Jenkins job XML
-	configXML := buildPipelineConfigXML(description, groovyScript)
+	configXML := buildPipelineConfigXML(description, groovyScript, pluginVersions)
 
 	// Check if job already exists (idempotency)
 	// FIX: Use GetJob and check for error (e.g., 404) instead of JobExists
@@ -332,8 +340,14 @@ func (r *jenkinsPipelineResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	pluginVersions := cachedPluginVersions(ctx, r.client, &resp.Diagnostics)
+	requirePlugins(pluginVersions, &resp.Diagnostics, pipelinePlugins...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Construct the updated Jenkins job XML
-	updatedConfigXML := buildPipelineConfigXML(newDescription, newGroovyScript)
+	updatedConfigXML := buildPipelineConfigXML(newDescription, newGroovyScript, pluginVersions)
 
 	updatedJob := r.client.UpdateJob(ctx, jobName, updatedConfigXML)
 	if updatedJob == nil {
@@ -0,0 +1,555 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsPluginResource{}
+var _ resource.ResourceWithImportState = &jenkinsPluginResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsPluginResource{}
+
+// NewJenkinsPluginResource is a helper function to simplify provider development.
+func NewJenkinsPluginResource() resource.Resource {
+	return &jenkinsPluginResource{}
+}
+
+// jenkinsPluginResource defines the resource implementation.
+type jenkinsPluginResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsPluginResourceModel describes the resource data model for a Jenkins plugin.
+type jenkinsPluginResourceModel struct {
+	ID               types.String `tfsdk:"id"`                // Unique identifier (plugin short name)
+	Name             types.String `tfsdk:"name"`              // Short name of the plugin
+	Version          types.String `tfsdk:"version"`           // Minimum version to install
+	OnDelete         types.String `tfsdk:"on_delete"`         // What to do with the plugin on destroy
+	TimeoutMinutes   types.Int64  `tfsdk:"timeout_minutes"`   // Maximum time to wait for the installation
+	InstalledVersion types.String `tfsdk:"installed_version"` // Version currently installed (computed)
+	LongName         types.String `tfsdk:"long_name"`         // Display name of the plugin (computed)
+	Enabled          types.Bool   `tfsdk:"enabled"`           // Whether the plugin is enabled (computed)
+	Active           types.Bool   `tfsdk:"active"`            // Whether the plugin is loaded (computed)
+	RestartRequired  types.Bool   `tfsdk:"restart_required"`  // Whether a restart is needed to complete changes (computed)
+}
+
+// updateCenterResponse mirrors the parts of the update center JSON API used to follow installations.
+type updateCenterResponse struct {
+	RestartRequiredForCompletion bool `json:"restartRequiredForCompletion"`
+	Jobs                         []struct {
+		ID     int64  `json:"id"`
+		Type   string `json:"type"`
+		Name   string `json:"name"`
+		Status struct {
+			Type    string `json:"type"`
+			Success bool   `json:"success"`
+		} `json:"status"`
+	} `json:"jobs"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsPluginResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_plugin" // e.g., jenkins_plugin
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsPluginResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Installs a Jenkins plugin from the update center.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The short name of the plugin.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The short name of the plugin (e.g., `git`, `workflow-aggregator`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The minimum version of the plugin. Jenkins installs the version offered by the update center when the installed one is older; older versions are never installed. When the installed version falls behind, it is installed again. Defaults to the latest version.",
+				Optional:            true,
+			},
+			"on_delete": schema.StringAttribute{
+				MarkdownDescription: "What happens to the plugin on destroy: `uninstall` or `disable`. Either takes effect after a restart. Defaults to `uninstall`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("uninstall"),
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The maximum time to wait for the plugin and its dependencies to be installed. Defaults to `10`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+			},
+			"installed_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the plugin that is installed.",
+				Computed:            true,
+			},
+			"long_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the plugin.",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plugin is enabled.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plugin is loaded. Plugins that cannot be loaded dynamically only become active after a restart.",
+				Computed:            true,
+			},
+			"restart_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether Jenkins must be restarted to complete plugin installations or updates.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the delete behaviour and timeout.
+func (r *jenkinsPluginResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsPluginResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.OnDelete.IsNull() && !config.OnDelete.IsUnknown() {
+		if onDelete := config.OnDelete.ValueString(); onDelete != "uninstall" && onDelete != "disable" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("on_delete"),
+				"Invalid Delete Behaviour",
+				fmt.Sprintf("'on_delete' must be 'uninstall' or 'disable', got: '%s'.", onDelete),
+			)
+		}
+	}
+	if !config.TimeoutMinutes.IsNull() && !config.TimeoutMinutes.IsUnknown() && config.TimeoutMinutes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_minutes"), "Invalid Timeout", "'timeout_minutes' must be at least 1.")
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsPluginResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// getInstalledPlugin returns an installed plugin by short name, or nil if it is not installed.
+func getInstalledPlugin(ctx context.Context, client *gojenkins.Jenkins, name string) (*gojenkins.Plugin, error) {
+	plugins, err := client.GetPlugins(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	for i := range plugins.Raw.Plugins {
+		if plugins.Raw.Plugins[i].ShortName == name {
+			return &plugins.Raw.Plugins[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// installedPluginVersions returns the versions of the active plugins by short name.
+func installedPluginVersions(ctx context.Context, client *gojenkins.Jenkins) (map[string]string, error) {
+	inventory := &pluginInventoryResponse{}
+	httpResp, err := client.Requester.GetJSON(ctx, "/pluginManager", inventory, map[string]string{
+		"tree": "plugins[shortName,version,active]",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+
+	versions := map[string]string{}
+	for _, plugin := range inventory.Plugins {
+		if plugin.Active {
+			versions[plugin.ShortName] = plugin.Version
+		}
+	}
+	return versions, nil
+}
+
+// pluginVersionCache holds the installed plugin versions of each client, so they are discovered
+// once, when a resource first generates a configuration, rather than on every request.
+// jenkins_plugin refreshes it after installing a plugin. Entries are replaced, never modified.
+var pluginVersionCache sync.Map // *gojenkins.Jenkins -> map[string]string
+
+// discoverPluginVersions reads the installed plugin versions of a client into the cache.
+func discoverPluginVersions(ctx context.Context, client *gojenkins.Jenkins) error {
+	versions, err := installedPluginVersions(ctx, client)
+	if err != nil {
+		return err
+	}
+	pluginVersionCache.Store(client, versions)
+	return nil
+}
+
+// cachedPluginVersions returns the installed plugin versions of a client, discovering them on
+// first use. Listing plugins requires administrator permission; when it fails, a warning is
+// added and nil is returned, so that configurations are generated without plugin versions.
+func cachedPluginVersions(ctx context.Context, client *gojenkins.Jenkins, diags *diag.Diagnostics) map[string]string {
+	if versions, ok := pluginVersionCache.Load(client); ok {
+		return versions.(map[string]string)
+	}
+	if err := discoverPluginVersions(ctx, client); err != nil {
+		diags.AddWarning(
+			"Jenkins Plugins Not Discovered",
+			fmt.Sprintf("Unable to list the plugins installed on Jenkins: %s. The configuration is generated without plugin versions and required plugins are not checked.", err.Error()),
+		)
+		return nil
+	}
+	return cachedPluginVersions(ctx, client, diags)
+}
+
+// pluginAttribute returns the `plugin` attribute of a config.xml element provided by a plugin:
+// `name@version` when the installed version is known, otherwise the bare name, which Jenkins accepts too.
+func pluginAttribute(versions map[string]string, name string) string {
+	if version, ok := versions[name]; ok {
+		return name + "@" + version
+	}
+	return name
+}
+
+// requirePlugins reports an error for each plugin that is not installed and active.
+// Nothing is reported when the installed plugins could not be discovered (nil versions);
+// cachedPluginVersions warns about that.
+func requirePlugins(versions map[string]string, diags *diag.Diagnostics, names ...string) {
+	if versions == nil {
+		return
+	}
+	for _, name := range names {
+		if _, ok := versions[name]; !ok {
+			diags.AddError(
+				"Required Jenkins Plugin Missing",
+				fmt.Sprintf("The Jenkins plugin '%s' is required but not installed or not active. Install it, e.g. with the jenkins_plugin resource, and restart Jenkins if needed.", name),
+			)
+		}
+	}
+}
+
+// getUpdateCenter returns the update center jobs and restart status.
+func getUpdateCenter(ctx context.Context, client *gojenkins.Jenkins) (*updateCenterResponse, error) {
+	center := &updateCenterResponse{}
+	httpResp, err := client.Requester.GetJSON(ctx, "/updateCenter", center, map[string]string{
+		"tree": "restartRequiredForCompletion,jobs[id,type,name,status[type,success]]",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return center, nil
+}
+
+// compareVersions compares two plugin versions by their numeric components, e.g.
+// `5.2.1` or `1254.v3f669a_b_a_083a_`, returning -1, 0 or 1.
+func compareVersions(a, b string) int {
+	split := func(version string) []int64 {
+		var numbers []int64
+		for _, field := range strings.FieldsFunc(version, func(r rune) bool { return r < '0' || r > '9' }) {
+			number, _ := strconv.ParseInt(field, 10, 64)
+			numbers = append(numbers, number)
+		}
+		return numbers
+	}
+	left, right := split(a), split(b)
+	for i := 0; i < len(left) || i < len(right); i++ {
+		var l, r int64
+		if i < len(left) {
+			l = left[i]
+		}
+		if i < len(right) {
+			r = right[i]
+		}
+		if l != r {
+			if l < r {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// installPlugin asks Jenkins to install a plugin and its dependencies, and waits until
+// the update center has finished the resulting installation jobs.
+func installPlugin(ctx context.Context, client *gojenkins.Jenkins, name, version string) error {
+	before, err := getUpdateCenter(ctx, client)
+	if err != nil {
+		return err
+	}
+	var lastJob int64
+	for _, job := range before.Jobs {
+		if job.ID > lastJob {
+			lastJob = job.ID
+		}
+	}
+
+	// Same request as gojenkins' InstallPlugin, which does not check for request errors
+	// before reading the status code
+	body := fmt.Sprintf(`<jenkins><install plugin="%s@%s" /></jenkins>`, escapeXML(name), escapeXML(version))
+	httpResp, err := client.Requester.PostXML(ctx, "/pluginManager/installNecessaryPlugins", body, nil, nil)
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusFound {
+		return fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+
+	return waitForPoll(ctx, func() (bool, error) {
+		center, err := getUpdateCenter(ctx, client)
+		if err != nil {
+			return false, err
+		}
+		done := true
+		for _, job := range center.Jobs {
+			if job.ID <= lastJob || job.Type != "InstallationJob" {
+				continue
+			}
+			switch job.Status.Type {
+			case "Pending", "Installing":
+				done = false
+			case "Failure":
+				return false, fmt.Errorf("installation of plugin '%s' failed, see the update center for details", job.Name)
+			}
+		}
+		return done, nil
+	})
+}
+
+// applyPlugin updates the model from an installed plugin and the update center.
+func applyPlugin(plugin *gojenkins.Plugin, center *updateCenterResponse, model *jenkinsPluginResourceModel) {
+	model.ID = types.StringValue(plugin.ShortName)
+	model.Name = types.StringValue(plugin.ShortName)
+	model.InstalledVersion = types.StringValue(plugin.Version)
+	model.LongName = types.StringValue(plugin.LongName)
+	model.Enabled = types.BoolValue(plugin.Enabled)
+	model.Active = types.BoolValue(plugin.Active)
+	model.RestartRequired = types.BoolValue(center.RestartRequiredForCompletion)
+}
+
+// Create installs the plugin.
+func (r *jenkinsPluginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsPluginResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.install(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins plugin '%s' installed successfully.", plan.Name.ValueString())
+}
+
+// install installs the planned plugin version and reads back the result into the model.
+func (r *jenkinsPluginResource) install(ctx context.Context, plan *jenkinsPluginResourceModel, diags *diag.Diagnostics) {
+	name := plan.Name.ValueString()
+	version := "latest"
+	if !plan.Version.IsNull() {
+		version = plan.Version.ValueString()
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Duration(plan.TimeoutMinutes.ValueInt64())*time.Minute)
+	defer cancel()
+
+	if err := installPlugin(waitCtx, r.client, name, version); err != nil {
+		diags.AddError(
+			"Jenkins Plugin Installation Error",
+			fmt.Sprintf("Failed to install Jenkins plugin '%s@%s': %s", name, version, err.Error()),
+		)
+		return
+	}
+
+	plugin, err := getInstalledPlugin(ctx, r.client, name)
+	if err == nil && plugin == nil {
+		err = fmt.Errorf("the plugin is not installed, check that the name is correct and available in the update center")
+	}
+	var center *updateCenterResponse
+	if err == nil {
+		center, err = getUpdateCenter(ctx, r.client)
+	}
+	if err != nil {
+		diags.AddError(
+			"Jenkins Plugin Read Error",
+			fmt.Sprintf("Failed to read Jenkins plugin '%s' after installation: %s", name, err.Error()),
+		)
+		return
+	}
+	applyPlugin(plugin, center, plan)
+
+	// Let resources that depend on the plugin see it without configuring the provider again
+	if err := discoverPluginVersions(ctx, r.client); err != nil {
+		log.Printf("[WARN] Could not refresh the installed Jenkins plugins: %s", err.Error())
+	}
+}
+
+// Read refreshes the Terraform state with the installed plugin.
+func (r *jenkinsPluginResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsPluginResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.ID.ValueString()
+	plugin, err := getInstalledPlugin(ctx, r.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Plugin Read Error",
+			fmt.Sprintf("Failed to read Jenkins plugin '%s': %s", name, err.Error()),
+		)
+		return
+	}
+	if plugin == nil || plugin.Deleted {
+		log.Printf("[INFO] Jenkins plugin '%s' not found or uninstalled, removing from state.", name)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	center, err := getUpdateCenter(ctx, r.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Plugin Read Error",
+			fmt.Sprintf("Failed to read the Jenkins update center: %s", err.Error()),
+		)
+		return
+	}
+	applyPlugin(plugin, center, &state)
+
+	// Report a plugin that fell behind the requested version, so that it is installed again
+	if !state.Version.IsNull() && compareVersions(plugin.Version, state.Version.ValueString()) < 0 {
+		state.Version = types.StringValue(plugin.Version)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update installs a new version of the plugin when the requested version changes.
+func (r *jenkinsPluginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state jenkinsPluginResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Version.Equal(state.Version) {
+		r.install(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		// Only on_delete or timeout_minutes changed
+		plan.ID = state.ID
+		plan.InstalledVersion = state.InstalledVersion
+		plan.LongName = state.LongName
+		plan.Enabled = state.Enabled
+		plan.Active = state.Active
+		plan.RestartRequired = state.RestartRequired
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins plugin '%s' updated successfully.", plan.Name.ValueString())
+}
+
+// Delete uninstalls or disables the plugin.
+func (r *jenkinsPluginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsPluginResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.ID.ValueString()
+
+	// Check if the plugin is still installed before attempting to remove it (idempotency)
+	plugin, err := getInstalledPlugin(ctx, r.client, name)
+	if err == nil && (plugin == nil || plugin.Deleted) {
+		log.Printf("[INFO] Jenkins plugin '%s' not found (already uninstalled).", name)
+		return
+	}
+
+	endpoint := "/doUninstall"
+	if state.OnDelete.ValueString() == "disable" {
+		endpoint = "/makeDisabled"
+	}
+	if err == nil {
+		var httpResp *http.Response
+		httpResp, err = r.client.Requester.Post(ctx, "/pluginManager/plugin/"+name+endpoint, nil, nil, nil)
+		if err == nil && httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusFound {
+			err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Plugin Deletion Error",
+			fmt.Sprintf("Failed to %s Jenkins plugin '%s': %s", state.OnDelete.ValueString(), name, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Jenkins Restart Required",
+		fmt.Sprintf("Jenkins plugin '%s' is removed or disabled once Jenkins restarts.", name),
+	)
+
+	log.Printf("[INFO] Jenkins plugin '%s' removed (%s) successfully.", name, state.OnDelete.ValueString())
+}
+
+// ImportState allows importing installed Jenkins plugins into Terraform state.
+func (r *jenkinsPluginResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The imported ID is the plugin short name.
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("on_delete"), "uninstall")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout_minutes"), int64(10))...)
+}
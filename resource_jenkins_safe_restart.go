@@ -0,0 +1,252 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// restartProbeTimeout bounds a single availability check while Jenkins restarts, as
+// requests to a controller that is shutting down can hang.
+const restartProbeTimeout = 30 * time.Second
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsSafeRestartResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsSafeRestartResource{}
+
+// NewJenkinsSafeRestartResource is a helper function to simplify provider development.
+func NewJenkinsSafeRestartResource() resource.Resource {
+	return &jenkinsSafeRestartResource{}
+}
+
+// jenkinsSafeRestartResource defines the resource implementation.
+type jenkinsSafeRestartResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsSafeRestartResourceModel describes the resource data model for a safe restart of the controller.
+type jenkinsSafeRestartResourceModel struct {
+	ID              types.String `tfsdk:"id"`               // Unique identifier (restart time)
+	Triggers        types.Map    `tfsdk:"triggers"`         // Arbitrary values that trigger a restart when changed
+	TimeoutMinutes  types.Int64  `tfsdk:"timeout_minutes"`  // Maximum time to wait for Jenkins to come back
+	RestartedAt     types.String `tfsdk:"restarted_at"`     // Time the restart was requested (computed)
+	DurationSeconds types.Int64  `tfsdk:"duration_seconds"` // Time until Jenkins was back (computed)
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsSafeRestartResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_safe_restart" // e.g., jenkins_safe_restart
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsSafeRestartResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Safely restarts the Jenkins controller on apply: Jenkins stops starting new builds, waits for running builds to finish and restarts. The apply completes once Jenkins is responsive again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time the restart was requested.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that trigger a new restart when they change (e.g., the installed versions of `jenkins_plugin` resources).",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: "The maximum time to wait for running builds to finish and Jenkins to come back. Defaults to `30`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(30),
+			},
+			"restarted_at": schema.StringAttribute{
+				MarkdownDescription: "The time the restart was requested, as an RFC 3339 timestamp.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"duration_seconds": schema.Int64Attribute{
+				MarkdownDescription: "The time from requesting the restart until Jenkins was responsive again, in seconds.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the timeout.
+func (r *jenkinsSafeRestartResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsSafeRestartResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TimeoutMinutes.IsNull() && !config.TimeoutMinutes.IsUnknown() && config.TimeoutMinutes.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_minutes"), "Invalid Timeout", "'timeout_minutes' must be at least 1.")
+	}
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsSafeRestartResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// jenkinsIsUp reports whether Jenkins answers requests and is not preparing a shutdown.
+// Connection errors and error responses, such as the 503 served while Jenkins starts,
+// count as down. The request is made directly, as gojenkins does not honour contexts.
+func jenkinsIsUp(ctx context.Context, client *gojenkins.Jenkins) bool {
+	ctx, cancel := context.WithTimeout(ctx, restartProbeTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, client.Server+"/api/json?tree=quietingDown", nil)
+	if err != nil {
+		return false
+	}
+	if client.Requester.BasicAuth != nil {
+		req.SetBasicAuth(client.Requester.BasicAuth.Username, client.Requester.BasicAuth.Password)
+	}
+
+	httpResp, err := client.Requester.Client.Do(req)
+	if err != nil {
+		log.Printf("[DEBUG] Jenkins is not reachable: %s", err.Error())
+		return false
+	}
+	defer httpResp.Body.Close()
+	if httpResp.StatusCode != http.StatusOK {
+		log.Printf("[DEBUG] Jenkins responded with status %d.", httpResp.StatusCode)
+		return false
+	}
+
+	var status struct {
+		QuietingDown bool `json:"quietingDown"`
+	}
+	if err := json.NewDecoder(httpResp.Body).Decode(&status); err != nil {
+		return false
+	}
+	// Quiet mode is entered by /safeRestart and lasts until the restart
+	return !status.QuietingDown
+}
+
+// Create requests a safe restart and waits until Jenkins is back.
+func (r *jenkinsSafeRestartResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsSafeRestartResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	started := time.Now()
+	httpResp, err := r.client.Requester.Post(ctx, "/safeRestart", nil, nil, nil)
+	// Jenkins redirects to its front page, which may already report 503 while shutting down
+	if err == nil && httpResp.StatusCode >= 400 && httpResp.StatusCode != http.StatusServiceUnavailable {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Safe Restart Error",
+			fmt.Sprintf("Failed to request a safe restart of Jenkins: %s", err.Error()),
+		)
+		return
+	}
+	log.Printf("[INFO] Jenkins safe restart requested, waiting for running builds to finish and Jenkins to come back.")
+
+	timeout := time.Duration(plan.TimeoutMinutes.ValueInt64()) * time.Minute
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err = waitForPoll(waitCtx, func() (bool, error) {
+		return jenkinsIsUp(waitCtx, r.client), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins Safe Restart Timeout",
+			fmt.Sprintf("Jenkins did not come back within %s after requesting a safe restart. It may still be waiting for running builds to finish: %s", timeout, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(started.UTC().Format(time.RFC3339))
+	plan.RestartedAt = plan.ID
+	plan.DurationSeconds = types.Int64Value(int64(time.Since(started).Seconds()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins restarted successfully in %d second(s).", plan.DurationSeconds.ValueInt64())
+}
+
+// Read keeps the recorded restart; there is nothing to refresh.
+func (r *jenkinsSafeRestartResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsSafeRestartResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records a changed timeout, which does not trigger a restart.
+func (r *jenkinsSafeRestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsSafeRestartResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the restart from Terraform state; it does not restart Jenkins.
+func (r *jenkinsSafeRestartResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsSafeRestartResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	log.Printf("[INFO] Jenkins safe restart '%s' removed from state.", state.ID.ValueString())
+}
@@ -0,0 +1,935 @@
+package main
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bndr/gojenkins"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected resource interfaces.
+var _ resource.Resource = &jenkinsViewResource{}
+var _ resource.ResourceWithImportState = &jenkinsViewResource{}
+var _ resource.ResourceWithValidateConfig = &jenkinsViewResource{}
+var _ resource.ResourceWithModifyPlan = &jenkinsViewResource{}
+
+// errViewNotFound is returned when Jenkins has no view at the requested path.
+var errViewNotFound = errors.New("view not found")
+
+// viewTypes maps the values of the `type` attribute to the root element of the view's config.xml.
+// XStream doubles underscores in class names, hence `nested__view`.
+var viewTypes = map[string]string{
+	"list":      "hudson.model.ListView",
+	"nested":    "hudson.plugins.nested__view.NestedView",
+	"dashboard": "hudson.plugins.view.dashboard.Dashboard",
+	"my":        "hudson.model.MyView",
+}
+
+// viewColumns maps the short column names accepted by the `columns` attribute to the
+// Jenkins column classes. Any other value is used verbatim as a column class.
+var viewColumns = map[string]string{
+	"status":        "hudson.views.StatusColumn",
+	"weather":       "hudson.views.WeatherColumn",
+	"name":          "hudson.views.JobColumn",
+	"last_success":  "hudson.views.LastSuccessColumn",
+	"last_failure":  "hudson.views.LastFailureColumn",
+	"last_stable":   "hudson.views.LastStableColumn",
+	"last_duration": "hudson.views.LastDurationColumn",
+	"build_button":  "hudson.views.BuildButtonColumn",
+}
+
+// javaClassNamePattern matches fully qualified Java class names as XStream writes them in
+// element names: `$` of nested classes is encoded as `_-`.
+var javaClassNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*((\.|_-)[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// defaultViewColumns are the columns Jenkins adds to a new list view.
+var defaultViewColumns = []string{"status", "weather", "name", "last_success", "last_failure", "last_duration", "build_button"}
+
+// defaultViewColumnsValue returns defaultViewColumns as the default of the `columns` attribute.
+func defaultViewColumnsValue() types.List {
+	columns := make([]attr.Value, 0, len(defaultViewColumns))
+	for _, column := range defaultViewColumns {
+		columns = append(columns, types.StringValue(column))
+	}
+	return types.ListValueMust(types.StringType, columns)
+}
+
+// NewJenkinsViewResource is a helper function to simplify provider development.
+func NewJenkinsViewResource() resource.Resource {
+	return &jenkinsViewResource{}
+}
+
+// jenkinsViewResource defines the resource implementation.
+type jenkinsViewResource struct {
+	client *gojenkins.Jenkins // Jenkins client instance
+}
+
+// jenkinsViewResourceModel describes the resource data model for a Jenkins view.
+type jenkinsViewResourceModel struct {
+	ID           types.String `tfsdk:"id"`            // Unique identifier (view path)
+	Name         types.String `tfsdk:"name"`          // Name of the view
+	Folder       types.String `tfsdk:"folder"`        // Full name of the folder owning the view
+	ParentView   types.String `tfsdk:"parent_view"`   // Path of the nested view owning the view
+	Type         types.String `tfsdk:"type"`          // View type (list, nested, dashboard, my)
+	ConfigXML    types.String `tfsdk:"config_xml"`    // Raw config.xml, replaces the typed attributes
+	Description  types.String `tfsdk:"description"`   // Description of the view
+	Jobs         types.Set    `tfsdk:"jobs"`          // Jobs explicitly added to the view
+	IncludeRegex types.String `tfsdk:"include_regex"` // Regular expression selecting additional jobs
+	Recurse      types.Bool   `tfsdk:"recurse"`       // Whether jobs in subfolders are included
+	Columns      types.List   `tfsdk:"columns"`       // Columns shown by the view
+}
+
+// viewConfig mirrors the parts of a view config.xml managed by this provider. The job
+// and column fields are only present for list and dashboard views.
+type viewConfig struct {
+	XMLName      xml.Name
+	Name         string   `xml:"name"`
+	Description  string   `xml:"description"`
+	JobNames     []string `xml:"jobNames>string"`
+	IncludeRegex string   `xml:"includeRegex"`
+	Recurse      bool     `xml:"recurse"`
+	Columns      struct {
+		Items []xmlElement `xml:",any"`
+	} `xml:"columns"`
+}
+
+// Metadata returns the resource's metadata.
+func (r *jenkinsViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_view" // e.g., jenkins_view
+}
+
+// Schema defines the resource's schema.
+func (r *jenkinsViewResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Jenkins view: a list, nested, dashboard or personal (`My View`) view.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the view: its folder, parent views and name joined by `/`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the view.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"folder": schema.StringAttribute{
+				MarkdownDescription: "The full name of the folder the view is created in (e.g., `team/services`). Defaults to the Jenkins root.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_view": schema.StringAttribute{
+				MarkdownDescription: "The nested view the view is created in, relative to `folder`. Nested views inside nested views are separated by `/`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The view type: `list`, `nested` (Nested View plugin), `dashboard` (Dashboard View plugin) or `my`. Defaults to `list`, or to the type of `config_xml` when set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"config_xml": schema.StringAttribute{
+				MarkdownDescription: "The raw config.xml of the view, used instead of the typed attributes. Drift is detected by checking that the configuration Jenkins returns contains its elements and values, ignoring formatting, `plugin` attributes and default elements Jenkins adds. The child views of a nested view are always preserved.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "A description for the view. Defaults to an empty string.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"jobs": schema.SetAttribute{
+				MarkdownDescription: "The names of the jobs explicitly added to a list or dashboard view, relative to its folder. Defaults to no jobs.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"include_regex": schema.StringAttribute{
+				MarkdownDescription: "A regular expression; jobs whose name matches it are included in a list or dashboard view. Defaults to an empty string, which includes no jobs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"recurse": schema.BoolAttribute{
+				MarkdownDescription: "Whether jobs inside subfolders are considered by a list or dashboard view. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"columns": schema.ListAttribute{
+				MarkdownDescription: "The columns of a list or dashboard view, in order. Accepts `status`, `weather`, `name`, `last_success`, `last_failure`, `last_stable`, `last_duration`, `build_button` or a fully qualified column class. Defaults to the Jenkins list view columns.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(defaultViewColumnsValue()),
+			},
+		},
+	}
+}
+
+// ValidateConfig checks attribute combinations that the schema cannot express.
+func (r *jenkinsViewResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jenkinsViewResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsNull() && !config.Type.IsUnknown() {
+		if _, ok := viewTypes[config.Type.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Invalid View Type",
+				fmt.Sprintf("Type must be one of 'list', 'nested', 'dashboard' or 'my', got: '%s'.", config.Type.ValueString()),
+			)
+			return
+		}
+	}
+
+	if !config.Columns.IsNull() && !config.Columns.IsUnknown() {
+		var columns []types.String
+		resp.Diagnostics.Append(config.Columns.ElementsAs(ctx, &columns, false)...)
+		for i, column := range columns {
+			if column.IsUnknown() {
+				continue
+			}
+			if _, ok := viewColumns[column.ValueString()]; !ok && !javaClassNamePattern.MatchString(column.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("columns").AtListIndex(i),
+					"Invalid View Column",
+					fmt.Sprintf("Column must be a short column name or a fully qualified column class, got: '%s'.", column.ValueString()),
+				)
+			}
+		}
+	}
+
+	typedAttributes := map[string]bool{
+		"description":   !config.Description.IsNull(),
+		"jobs":          !config.Jobs.IsNull(),
+		"include_regex": !config.IncludeRegex.IsNull(),
+		"recurse":       !config.Recurse.IsNull(),
+		"columns":       !config.Columns.IsNull(),
+	}
+	viewType := config.Type.ValueString()
+
+	for attribute, set := range typedAttributes {
+		if !set {
+			continue
+		}
+		if !config.ConfigXML.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Conflicting View Configuration",
+				fmt.Sprintf("'%s' cannot be combined with 'config_xml'.", attribute),
+			)
+		} else if attribute != "description" && (viewType == "nested" || viewType == "my") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unsupported View Attribute",
+				fmt.Sprintf("'%s' is only supported by list and dashboard views.", attribute),
+			)
+		}
+	}
+}
+
+// ModifyPlan derives `type` from the root class of `config_xml`, replacing the view when the
+// class changes. The typed attributes are read back from the view configuration when
+// `config_xml` is used, so their defaults are replaced by the recorded values, or by unknown
+// values when `config_xml` changes. Nested and personal views have no job or column settings.
+func (r *jenkinsViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // Resource is being destroyed
+	}
+
+	var plan, state, config jenkinsViewResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ConfigXML.IsNull() && !config.ConfigXML.IsUnknown() {
+		viewType, err := configXMLViewType(config.ConfigXML.ValueString())
+		if err == nil && !config.Type.IsNull() && !config.Type.IsUnknown() && config.Type.ValueString() != viewType {
+			err = fmt.Errorf("config_xml describes a '%s' view, but type is '%s'", viewType, config.Type.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("config_xml"), "Invalid View Configuration", err.Error())
+			return
+		}
+		plan.Type = types.StringValue(viewType)
+		// Jenkins cannot change the class of a view, so a new root element replaces it
+		if !req.State.Raw.IsNull() && state.Type.ValueString() != viewType {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+		}
+	} else if config.ConfigXML.IsUnknown() && config.Type.IsNull() {
+		plan.Type = types.StringUnknown()
+	}
+
+	if !config.ConfigXML.IsNull() {
+		// The typed attributes conflict with config_xml, so they only hold the defaults here
+		if !req.State.Raw.IsNull() && plan.ConfigXML.Equal(state.ConfigXML) {
+			plan.Description = state.Description
+			plan.Jobs = state.Jobs
+			plan.IncludeRegex = state.IncludeRegex
+			plan.Recurse = state.Recurse
+			plan.Columns = state.Columns
+		} else {
+			plan.Description = types.StringUnknown()
+			plan.Jobs = types.SetUnknown(types.StringType)
+			plan.IncludeRegex = types.StringUnknown()
+			plan.Recurse = types.BoolUnknown()
+			plan.Columns = types.ListUnknown(types.StringType)
+		}
+	} else if viewType := plan.Type.ValueString(); viewType == "nested" || viewType == "my" {
+		plan.Jobs = types.SetNull(types.StringType)
+		plan.IncludeRegex = types.StringValue("")
+		plan.Recurse = types.BoolValue(false)
+		plan.Columns = types.ListNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Configure retrieves the Jenkins client from the provider configuration.
+func (r *jenkinsViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return // Provider not configured yet, or no client passed
+	}
+
+	client, ok := req.ProviderData.(*gojenkins.Jenkins)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *gojenkins.Jenkins, got: %T. Please report this issue to the provider developer.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// viewOwnerURL returns the API path of the item group owning a view: a folder, or nested views inside it.
+func viewOwnerURL(folder, parentView string) string {
+	var b strings.Builder
+	b.WriteString(folderURL(folder))
+	for _, segment := range strings.Split(parentView, "/") {
+		if segment != "" {
+			b.WriteString("/view/" + url.PathEscape(segment))
+		}
+	}
+	return b.String()
+}
+
+// viewURL returns the API path of a view.
+func viewURL(folder, parentView, name string) string {
+	return viewOwnerURL(folder, parentView) + "/view/" + url.PathEscape(name)
+}
+
+// joinViewPath builds the path identifying a view from its folder, parent views and name.
+func joinViewPath(folder, parentView, name string) string {
+	var segments []string
+	for _, part := range []string{folder, parentView, name} {
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// viewTypeOf returns the `type` value matching the root element of a view config.xml.
+func viewTypeOf(rootElement string) (string, bool) {
+	for viewType, element := range viewTypes {
+		if element == rootElement {
+			return viewType, true
+		}
+	}
+	return "", false
+}
+
+// buildViewConfigXML generates the config.xml of a view from the typed resource attributes.
+func buildViewConfigXML(ctx context.Context, model *jenkinsViewResourceModel) (string, error) {
+	viewType := model.Type.ValueString()
+	rootElement := viewTypes[viewType]
+
+	var body strings.Builder
+	fmt.Fprintf(&body, `
+  <name>%s</name>
+  <description>%s</description>
+  <filterExecutors>false</filterExecutors>
+  <filterQueue>false</filterQueue>
+  <properties class="hudson.model.View$PropertyList"/>`,
+		escapeXML(model.Name.ValueString()),
+		escapeXML(model.Description.ValueString()),
+	)
+
+	switch viewType {
+	case "nested":
+		// Child views are spliced in from the current configuration before upload
+		body.WriteString("\n  <views/>")
+	case "list", "dashboard":
+		var jobs []string
+		if !model.Jobs.IsNull() && !model.Jobs.IsUnknown() {
+			if diags := model.Jobs.ElementsAs(ctx, &jobs, false); diags.HasError() {
+				return "", fmt.Errorf("could not read jobs")
+			}
+			sort.Strings(jobs)
+		}
+
+		columns := defaultViewColumns
+		if !model.Columns.IsNull() && !model.Columns.IsUnknown() {
+			columns = nil
+			if diags := model.Columns.ElementsAs(ctx, &columns, false); diags.HasError() {
+				return "", fmt.Errorf("could not read columns")
+			}
+		}
+
+		body.WriteString(`
+  <jobNames>
+    <comparator class="hudson.util.CaseInsensitiveComparator"/>`)
+		for _, job := range jobs {
+			fmt.Fprintf(&body, "\n    <string>%s</string>", escapeXML(job))
+		}
+		body.WriteString(`
+  </jobNames>
+  <jobFilters/>
+  <columns>`)
+		for _, column := range columns {
+			class, ok := viewColumns[column]
+			if !ok {
+				class = column
+			}
+			fmt.Fprintf(&body, "\n    <%s/>", class)
+		}
+		fmt.Fprintf(&body, `
+  </columns>
+  <includeRegex>%s</includeRegex>
+  <recurse>%t</recurse>`,
+			escapeXML(model.IncludeRegex.ValueString()),
+			model.Recurse.ValueBool(),
+		)
+
+		if viewType == "dashboard" {
+			body.WriteString(`
+  <useCssStyle>false</useCssStyle>
+  <includeStdJobList>true</includeStdJobList>
+  <hideJenkinsPanels>false</hideJenkinsPanels>
+  <leftPortletWidth>50%</leftPortletWidth>
+  <rightPortletWidth>50%</rightPortletWidth>
+  <leftPortlets/>
+  <rightPortlets/>
+  <topPortlets/>
+  <bottomPortlets/>`)
+		}
+	}
+
+	configXML := fmt.Sprintf(`<?xml version='1.1' encoding='UTF-8'?>
+<%s>%s
+</%s>`, rootElement, body.String(), rootElement)
+	return configXML, nil
+}
+
+// replaceTopLevelElement replaces the first child element of the document root named
+// `name` with `replacement`. When there is no such element, the replacement is inserted
+// before the end of the root element.
+func replaceTopLevelElement(doc, name, replacement string) (string, error) {
+	doc = strings.Replace(doc, "version='1.1'", "version='1.0'", 1)
+	doc = strings.Replace(doc, `version="1.1"`, `version="1.0"`, 1)
+
+	decoder := xml.NewDecoder(strings.NewReader(doc))
+	depth := 0
+	start := int64(-1)
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			if depth == 2 && start == -1 && t.Name.Local == name {
+				start = offset
+			}
+		case xml.EndElement:
+			depth--
+			if depth == 1 && start != -1 && t.Name.Local == name {
+				return doc[:start] + replacement + doc[decoder.InputOffset():], nil
+			}
+			if depth == 0 {
+				return doc[:offset] + replacement + doc[offset:], nil
+			}
+		}
+	}
+}
+
+// nestedViewChildren returns the <views> element of a nested view config.xml, holding
+// its child views, or an empty element when it is missing.
+func nestedViewChildren(doc string) (string, error) {
+	var holder struct {
+		Views *struct {
+			Content string `xml:",innerxml"`
+		} `xml:"views"`
+	}
+	if err := unmarshalJenkinsXML(doc, &holder); err != nil {
+		return "", err
+	}
+	if holder.Views == nil {
+		return "<views/>", nil
+	}
+	return "<views>" + holder.Views.Content + "</views>", nil
+}
+
+// readViewConfigXML fetches the raw config.xml of a view.
+func readViewConfigXML(ctx context.Context, client *gojenkins.Jenkins, folder, parentView, name string) (string, error) {
+	var raw string
+	httpResp, err := client.Requester.GetXML(ctx, viewURL(folder, parentView, name)+"/config.xml", &raw, nil)
+	if err != nil {
+		return "", err
+	}
+	if httpResp.StatusCode == http.StatusNotFound {
+		return "", errViewNotFound
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return raw, nil
+}
+
+// createView creates a view from a config.xml document inside its owner.
+func createView(ctx context.Context, client *gojenkins.Jenkins, folder, parentView, name, configXML string) error {
+	httpResp, err := client.Requester.PostXML(ctx, viewOwnerURL(folder, parentView)+"/createView", configXML, nil, map[string]string{
+		"name": name,
+	})
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return nil
+}
+
+// updateViewConfig uploads a new config.xml for a view.
+func updateViewConfig(ctx context.Context, client *gojenkins.Jenkins, folder, parentView, name, configXML string) error {
+	httpResp, err := client.Requester.PostXML(ctx, viewURL(folder, parentView, name)+"/config.xml", configXML, nil, nil)
+	if err != nil {
+		return err
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	return nil
+}
+
+// configXMLViewType returns the `type` value matching the root class of a raw config.xml.
+func configXMLViewType(configXML string) (string, error) {
+	root := &xmlElement{}
+	if err := unmarshalJenkinsXML(configXML, root); err != nil {
+		return "", fmt.Errorf("could not parse config_xml: %s", err.Error())
+	}
+	viewType, ok := viewTypeOf(root.XMLName.Local)
+	if !ok {
+		return "", fmt.Errorf("unsupported view class '%s' in config_xml", root.XMLName.Local)
+	}
+	return viewType, nil
+}
+
+// desiredViewConfigXML returns the config.xml to upload for the planned view: the raw
+// `config_xml` when set, otherwise the document generated from the typed attributes.
+func desiredViewConfigXML(ctx context.Context, model *jenkinsViewResourceModel) (string, error) {
+	if !model.ConfigXML.IsNull() {
+		viewType, err := configXMLViewType(model.ConfigXML.ValueString())
+		if err != nil {
+			return "", err
+		}
+		if !model.Type.IsUnknown() && model.Type.ValueString() != viewType {
+			return "", fmt.Errorf("config_xml describes a '%s' view, but type is '%s'", viewType, model.Type.ValueString())
+		}
+		model.Type = types.StringValue(viewType)
+		return model.ConfigXML.ValueString(), nil
+	}
+
+	if model.Type.IsUnknown() || model.Type.IsNull() {
+		model.Type = types.StringValue("list")
+	}
+	return buildViewConfigXML(ctx, model)
+}
+
+// applyViewConfig copies the view configuration read from Jenkins into the typed attributes
+// of the resource model. `config_xml` is left as planned; Read compares it separately.
+func applyViewConfig(ctx context.Context, configXML string, model *jenkinsViewResourceModel) error {
+	config := &viewConfig{}
+	if err := unmarshalJenkinsXML(configXML, config); err != nil {
+		return fmt.Errorf("could not parse view config.xml: %s", err.Error())
+	}
+	viewType, ok := viewTypeOf(config.XMLName.Local)
+	if !ok {
+		return fmt.Errorf("unsupported view class '%s'", config.XMLName.Local)
+	}
+
+	model.Type = types.StringValue(viewType)
+	model.Description = types.StringValue(config.Description)
+
+	if viewType != "list" && viewType != "dashboard" {
+		model.Jobs = types.SetNull(types.StringType)
+		model.IncludeRegex = types.StringValue("")
+		model.Recurse = types.BoolValue(false)
+		model.Columns = types.ListNull(types.StringType)
+		return nil
+	}
+
+	model.IncludeRegex = types.StringValue(config.IncludeRegex)
+	model.Recurse = types.BoolValue(config.Recurse)
+
+	jobNames := config.JobNames
+	if jobNames == nil {
+		jobNames = []string{}
+	}
+	jobs, diags := types.SetValueFrom(ctx, types.StringType, jobNames)
+	if diags.HasError() {
+		return fmt.Errorf("could not convert jobs")
+	}
+	model.Jobs = jobs
+
+	columns := make([]string, 0, len(config.Columns.Items))
+	for _, item := range config.Columns.Items {
+		column := item.XMLName.Local
+		for short, class := range viewColumns {
+			if class == column {
+				column = short
+			}
+		}
+		columns = append(columns, column)
+	}
+	columnList, diags := types.ListValueFrom(ctx, types.StringType, columns)
+	if diags.HasError() {
+		return fmt.Errorf("could not convert columns")
+	}
+	model.Columns = columnList
+	return nil
+}
+
+// refreshViewConfigXML keeps the raw `config_xml` of the model unless Jenkins holds a
+// different configuration, in which case the Jenkins document is adopted to show the drift.
+func refreshViewConfigXML(configXML string, model *jenkinsViewResourceModel) error {
+	if model.ConfigXML.IsNull() {
+		return nil
+	}
+	actual, err := parseXMLNode(configXML)
+	if err != nil {
+		return fmt.Errorf("could not parse view config.xml: %s", err.Error())
+	}
+	desired, err := parseXMLNode(model.ConfigXML.ValueString())
+	if err != nil {
+		model.ConfigXML = types.StringValue(configXML)
+		return nil
+	}
+	if model.Type.ValueString() == "nested" {
+		// Child views are managed separately and always preserved
+		children := desired.Children[:0]
+		for _, child := range desired.Children {
+			if child.Name != "views" {
+				children = append(children, child)
+			}
+		}
+		desired.Children = children
+	}
+	if !xmlNodeContains(desired, actual) {
+		model.ConfigXML = types.StringValue(configXML)
+	}
+	return nil
+}
+
+// Create a new Jenkins view.
+func (r *jenkinsViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan jenkinsViewResourceModel
+
+	// Get the plan (desired state) from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewName := plan.Name.ValueString()
+	folder := plan.Folder.ValueString()
+	parentView := plan.ParentView.ValueString()
+	viewPath := joinViewPath(folder, parentView, viewName)
+
+	configXML, err := desiredViewConfigXML(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Configuration Error",
+			fmt.Sprintf("Failed to build configuration for view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	// Check if view already exists (idempotency)
+	_, err = readViewConfigXML(ctx, r.client, folder, parentView, viewName)
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"View Already Exists",
+			fmt.Sprintf("Jenkins view '%s' already exists. Consider importing it or using a different name.", viewPath),
+		)
+		return
+	} else if !errors.Is(err, errViewNotFound) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Failed to check if view '%s' exists: %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	if err := createView(ctx, r.client, folder, parentView, viewName, configXML); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Creation Error",
+			fmt.Sprintf("Failed to create Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	// Read back the created view to ensure consistency
+	createdXML, err := readViewConfigXML(ctx, r.client, folder, parentView, viewName)
+	if err == nil {
+		err = applyViewConfig(ctx, createdXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Read Error After Create",
+			fmt.Sprintf("Failed to read created Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(viewPath)
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins view '%s' created successfully.", viewPath)
+}
+
+// Read retrieves the current state of a Jenkins view.
+func (r *jenkinsViewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state jenkinsViewResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewPath := state.ID.ValueString()
+	viewName := state.Name.ValueString()
+	folder := state.Folder.ValueString()
+	parentView := state.ParentView.ValueString()
+
+	configXML, err := readViewConfigXML(ctx, r.client, folder, parentView, viewName)
+	if err != nil {
+		if errors.Is(err, errViewNotFound) {
+			// View no longer exists in Jenkins, remove from Terraform state
+			resp.State.RemoveResource(ctx)
+			log.Printf("[INFO] Jenkins view '%s' not found, removing from state.", viewPath)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Jenkins View Read Error",
+			fmt.Sprintf("Failed to read Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	err = applyViewConfig(ctx, configXML, &state)
+	if err == nil {
+		err = refreshViewConfigXML(configXML, &state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Read Error",
+			fmt.Sprintf("Failed to read Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	log.Printf("[INFO] Jenkins view '%s' read successfully.", viewPath)
+}
+
+// Update an existing Jenkins view.
+func (r *jenkinsViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan jenkinsViewResourceModel
+	var state jenkinsViewResourceModel
+
+	// Get the plan (desired state) and current state from Terraform
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewPath := state.ID.ValueString()
+	viewName := state.Name.ValueString()
+	folder := state.Folder.ValueString()
+	parentView := state.ParentView.ValueString()
+
+	configXML, err := desiredViewConfigXML(ctx, &plan)
+	if err == nil && plan.Type.ValueString() == "nested" {
+		// Uploading a nested view replaces its children, carry the current ones over
+		var currentXML, children string
+		currentXML, err = readViewConfigXML(ctx, r.client, folder, parentView, viewName)
+		if err == nil {
+			children, err = nestedViewChildren(currentXML)
+		}
+		if err == nil {
+			configXML, err = replaceTopLevelElement(configXML, "views", children)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Configuration Error",
+			fmt.Sprintf("Failed to build configuration for view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	if err := updateViewConfig(ctx, r.client, folder, parentView, viewName, configXML); err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Update Error",
+			fmt.Sprintf("Failed to update Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	// Re-fetch the view after update
+	updatedXML, err := readViewConfigXML(ctx, r.client, folder, parentView, viewName)
+	if err == nil {
+		err = applyViewConfig(ctx, updatedXML, &plan)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Read Error After Update",
+			fmt.Sprintf("Failed to re-read updated Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	// Set the state in Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	log.Printf("[INFO] Jenkins view '%s' updated successfully.", viewPath)
+}
+
+// Delete a Jenkins view.
+func (r *jenkinsViewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jenkinsViewResourceModel
+
+	// Get the current state from Terraform
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewPath := state.ID.ValueString()
+	viewName := state.Name.ValueString()
+	folder := state.Folder.ValueString()
+	parentView := state.ParentView.ValueString()
+
+	// Check if view exists before attempting to delete (idempotency)
+	if _, err := readViewConfigXML(ctx, r.client, folder, parentView, viewName); errors.Is(err, errViewNotFound) {
+		log.Printf("[INFO] Jenkins view '%s' not found (already deleted).", viewPath)
+		return
+	}
+
+	httpResp, err := r.client.Requester.Post(ctx, viewURL(folder, parentView, viewName)+"/doDelete", nil, nil, nil)
+	if err == nil && httpResp.StatusCode != http.StatusOK {
+		err = fmt.Errorf("Jenkins responded with status %d", httpResp.StatusCode)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Jenkins View Deletion Error",
+			fmt.Sprintf("Failed to delete Jenkins view '%s': %s", viewPath, err.Error()),
+		)
+		return
+	}
+
+	log.Printf("[INFO] Jenkins view '%s' deleted successfully.", viewPath)
+}
+
+// ImportState allows importing existing Jenkins views into Terraform state.
+// The ID is the view path, e.g. `team/services/my-view`. Since folders and nested
+// views share the path syntax, each split is tried, preferring the deepest folder.
+func (r *jenkinsViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	segments := strings.Split(strings.Trim(req.ID, "/"), "/")
+	viewName := segments[len(segments)-1]
+	owners := segments[:len(segments)-1]
+
+	for split := len(owners); split >= 0; split-- {
+		folder := strings.Join(owners[:split], "/")
+		parentView := strings.Join(owners[split:], "/")
+
+		_, err := readViewConfigXML(ctx, r.client, folder, parentView, viewName)
+		if errors.Is(err, errViewNotFound) {
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Jenkins View Import Error",
+				fmt.Sprintf("Failed to look up Jenkins view '%s': %s", req.ID, err.Error()),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), joinViewPath(folder, parentView, viewName))...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), viewName)...)
+		if folder != "" {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("folder"), folder)...)
+		}
+		if parentView != "" {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_view"), parentView)...)
+		}
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Jenkins View Not Found",
+		fmt.Sprintf("No Jenkins view found at path '%s'.", req.ID),
+	)
+}